@@ -1,8 +1,6 @@
 package binarysearch
 
 import (
-	"math"
-
 	"github.com/Nigel2392/go-datastructures"
 )
 
@@ -22,24 +20,78 @@ func MakeComparableSlice[T datastructures.Ordered](arr []T) []datastructures.Com
 	return newArr
 }
 
+// Search for a value in a sorted slice of Comparable[T] values.
+//
+// Returns the index of the value, or -1 if it is not present.
 func Search[T datastructures.Comparable[T]](arr []T, val T) int {
-	return findTarget(arr, val, 0, len(arr)-1)
+	var i = FirstTrue(0, len(arr), func(i int) bool {
+		return !arr[i].Lt(val)
+	})
+	if i < len(arr) && !val.Lt(arr[i]) {
+		return i
+	}
+	return -1
 }
 
-func findTarget[T datastructures.Comparable[T]](arr []T, val T, start, end int) int {
-	if start > end {
-		return -1
+// Search for a value in a sorted slice of ordered values.
+//
+// Returns the index of the value, or -1 if it is not present.
+func SearchOrdered[T datastructures.Ordered](arr []T, val T) int {
+	var i = LowerBound(arr, val)
+	if i < len(arr) && arr[i] == val {
+		return i
 	}
+	return -1
+}
 
-	var mid = int(math.Floor(float64(start+end) / 2))
-	if !arr[mid].Lt(val) && !val.Lt(arr[mid]) {
-		return mid
-	}
-	if val.Lt(arr[mid]) {
-		return findTarget(arr, val, start, mid-1)
-	}
-	if arr[mid].Lt(val) {
-		return findTarget(arr, val, mid+1, end)
+// Search a sorted slice with a comparison function.
+//
+// The comparison function reports how an element relates to the target:
+// a negative number if the element is less than the target,
+// zero if it is equal and a positive number if it is greater.
+//
+// Returns the index of the matching element, or -1 if it is not present.
+func SearchFunc[T any](arr []T, cmp func(T) int) int {
+	var i = FirstTrue(0, len(arr), func(i int) bool {
+		return cmp(arr[i]) >= 0
+	})
+	if i < len(arr) && cmp(arr[i]) == 0 {
+		return i
 	}
 	return -1
 }
+
+// Returns the index of the first element which is not less than the value.
+//
+// Returns len(arr) if all elements are less than the value.
+func LowerBound[T datastructures.Ordered](arr []T, val T) int {
+	return FirstTrue(0, len(arr), func(i int) bool {
+		return arr[i] >= val
+	})
+}
+
+// Returns the index of the first element which is greater than the value.
+//
+// Returns len(arr) if no element is greater than the value.
+func UpperBound[T datastructures.Ordered](arr []T, val T) int {
+	return FirstTrue(0, len(arr), func(i int) bool {
+		return arr[i] > val
+	})
+}
+
+// Returns the half-open range [start, end) of elements equal to the value.
+//
+// The range is empty (start == end) if the value is not present,
+// in which case start is where the value would be inserted.
+func EqualRange[T datastructures.Ordered](arr []T, val T) (start, end int) {
+	start = LowerBound(arr, val)
+	end = start + UpperBound(arr[start:], val)
+	return start, end
+}
+
+// Returns the index at which the value should be inserted to keep the slice sorted,
+// and whether the value is already present at that index.
+func InsertionPoint[T datastructures.Ordered](arr []T, val T) (index int, found bool) {
+	index = LowerBound(arr, val)
+	return index, index < len(arr) && arr[index] == val
+}
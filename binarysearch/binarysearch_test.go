@@ -0,0 +1,126 @@
+package binarysearch_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/binarysearch"
+)
+
+var sorted = []int{1, 3, 3, 3, 5, 7, 9, 9, 11}
+
+type cmpInt int
+
+func (a cmpInt) Lt(b cmpInt) bool {
+	return a < b
+}
+
+func TestSearch(t *testing.T) {
+	var arr = make([]cmpInt, len(sorted))
+	for i, v := range sorted {
+		arr[i] = cmpInt(v)
+	}
+	for _, v := range sorted {
+		var idx = binarysearch.Search(arr, cmpInt(v))
+		if idx == -1 || sorted[idx] != v {
+			t.Fatalf("Expected to find %d, got index %d", v, idx)
+		}
+	}
+
+	for _, v := range []int{0, 2, 4, 6, 8, 10, 12} {
+		if idx := binarysearch.Search(arr, cmpInt(v)); idx != -1 {
+			t.Fatalf("Expected %d not to be found, got index %d", v, idx)
+		}
+	}
+
+	if idx := binarysearch.Search(arr[:0], arr[0]); idx != -1 {
+		t.Fatalf("Expected -1 for an empty slice, got %d", idx)
+	}
+}
+
+func TestSearchOrdered(t *testing.T) {
+	for _, v := range sorted {
+		var idx = binarysearch.SearchOrdered(sorted, v)
+		if idx == -1 || sorted[idx] != v {
+			t.Fatalf("Expected to find %d, got index %d", v, idx)
+		}
+	}
+	for _, v := range []int{0, 2, 4, 6, 8, 10, 12} {
+		if idx := binarysearch.SearchOrdered(sorted, v); idx != -1 {
+			t.Fatalf("Expected %d not to be found, got index %d", v, idx)
+		}
+	}
+	if idx := binarysearch.SearchOrdered([]int{}, 1); idx != -1 {
+		t.Fatalf("Expected -1 for an empty slice, got %d", idx)
+	}
+	if idx := binarysearch.SearchOrdered([]int{1}, 1); idx != 0 {
+		t.Fatalf("Expected 0 for a single element slice, got %d", idx)
+	}
+
+	var words = []string{"apple", "banana", "cherry"}
+	if idx := binarysearch.SearchOrdered(words, "banana"); idx != 1 {
+		t.Fatalf("Expected 1, got %d", idx)
+	}
+}
+
+func TestSearchFunc(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	var people = []person{{"a", 10}, {"b", 20}, {"c", 30}}
+	for i, p := range people {
+		var idx = binarysearch.SearchFunc(people, func(q person) int {
+			return strings.Compare(q.name, p.name)
+		})
+		if idx != i {
+			t.Fatalf("Expected %d, got %d", i, idx)
+		}
+	}
+	var idx = binarysearch.SearchFunc(people, func(q person) int {
+		return q.age - 25
+	})
+	if idx != -1 {
+		t.Fatalf("Expected -1, got %d", idx)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	var tests = []struct {
+		val   int
+		lower int
+		upper int
+	}{
+		{0, 0, 0},
+		{1, 0, 1},
+		{2, 1, 1},
+		{3, 1, 4},
+		{9, 6, 8},
+		{11, 8, 9},
+		{12, 9, 9},
+	}
+
+	for _, test := range tests {
+		if lower := binarysearch.LowerBound(sorted, test.val); lower != test.lower {
+			t.Errorf("LowerBound(%d): expected %d, got %d", test.val, test.lower, lower)
+		}
+		if upper := binarysearch.UpperBound(sorted, test.val); upper != test.upper {
+			t.Errorf("UpperBound(%d): expected %d, got %d", test.val, test.upper, upper)
+		}
+		var start, end = binarysearch.EqualRange(sorted, test.val)
+		if start != test.lower || end != test.upper {
+			t.Errorf("EqualRange(%d): expected [%d, %d), got [%d, %d)", test.val, test.lower, test.upper, start, end)
+		}
+		var idx, found = binarysearch.InsertionPoint(sorted, test.val)
+		if idx != test.lower || found != (test.lower != test.upper) {
+			t.Errorf("InsertionPoint(%d): expected %d %v, got %d %v", test.val, test.lower, test.lower != test.upper, idx, found)
+		}
+	}
+
+	if lower := binarysearch.LowerBound([]int(nil), 1); lower != 0 {
+		t.Errorf("LowerBound on nil: expected 0, got %d", lower)
+	}
+	if upper := binarysearch.UpperBound([]int(nil), 1); upper != 0 {
+		t.Errorf("UpperBound on nil: expected 0, got %d", upper)
+	}
+}
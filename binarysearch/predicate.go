@@ -0,0 +1,69 @@
+package binarysearch
+
+import "math"
+
+// Returns the smallest integer in [lo, hi) for which pred returns true,
+// or hi if there is none.
+//
+// The predicate must be monotone: false for a prefix of the range and true for the rest.
+//
+// This is useful to binary search on an answer instead of on a slice,
+// for example the smallest buffer size for which a request succeeds.
+func FirstTrue(lo, hi int, pred func(int) bool) int {
+	for lo < hi {
+		// avoid overflow when computing the midpoint
+		var mid = lo + int(uint(hi-lo)>>1)
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+// Returns the smallest x in [lo, hi] for which pred returns true, to within eps.
+//
+// The predicate must be monotone: false below some threshold and true from there on.
+//
+// The result is always a value for which pred returned true, or hi if pred was never true.
+//
+// If eps is zero, negative or NaN, bisection continues until the float64 precision is exhausted.
+// Infinite bounds are searched as if they were -math.MaxFloat64 and math.MaxFloat64.
+func FloatBisect(lo, hi float64, eps float64, pred func(float64) bool) float64 {
+	if math.IsNaN(eps) {
+		eps = 0
+	}
+	var end = hi
+	lo = math.Max(lo, -math.MaxFloat64)
+	hi = math.Min(hi, math.MaxFloat64)
+
+	var found = false
+	for hi-lo > eps {
+		// Halve both bounds first, hi-lo overflows when they are far apart.
+		var mid = lo/2 + hi/2
+		if mid <= lo || mid >= hi {
+			break
+		}
+		if pred(mid) {
+			hi, found = mid, true
+		} else {
+			lo = mid
+		}
+	}
+	if !found {
+		return end
+	}
+	return hi
+}
+
+// Returns the index of the first element for which pred returns false.
+//
+// The slice must be partitioned: all elements for which pred returns true come first.
+//
+// Returns len(arr) if pred returns true for all elements.
+func PartitionPoint[T any](arr []T, pred func(T) bool) int {
+	return FirstTrue(0, len(arr), func(i int) bool {
+		return !pred(arr[i])
+	})
+}
@@ -0,0 +1,104 @@
+package binarysearch_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/binarysearch"
+)
+
+func TestFirstTrue(t *testing.T) {
+	var tests = []struct {
+		lo, hi    int
+		threshold int
+		want      int
+	}{
+		{0, 0, 0, 0},      // empty range
+		{5, 5, 0, 5},      // empty range, not at zero
+		{0, 1, 0, 0},      // single element, true
+		{0, 1, 1, 1},      // single element, false
+		{0, 10, -5, 0},    // all true
+		{0, 10, 10, 10},   // all false
+		{0, 10, 7, 7},     // in the middle
+		{-10, 10, -3, -3}, // negative range
+	}
+
+	for _, test := range tests {
+		var got = binarysearch.FirstTrue(test.lo, test.hi, func(i int) bool {
+			if i < test.lo || i >= test.hi {
+				t.Fatalf("FirstTrue(%d, %d): predicate called with %d", test.lo, test.hi, i)
+			}
+			return i >= test.threshold
+		})
+		if got != test.want {
+			t.Errorf("FirstTrue(%d, %d) with threshold %d: expected %d, got %d", test.lo, test.hi, test.threshold, test.want, got)
+		}
+	}
+
+	// Must not overflow on large ranges
+	var big = math.MaxInt - 10
+	if got := binarysearch.FirstTrue(big, math.MaxInt, func(i int) bool { return i >= big+5 }); got != big+5 {
+		t.Errorf("Expected %d, got %d", big+5, got)
+	}
+}
+
+func TestFloatBisect(t *testing.T) {
+	var sqrt2 = binarysearch.FloatBisect(0, 2, 1e-9, func(x float64) bool {
+		return x*x >= 2
+	})
+	if math.Abs(sqrt2-math.Sqrt2) > 1e-9 || sqrt2*sqrt2 < 2 {
+		t.Errorf("Expected %v, got %v", math.Sqrt2, sqrt2)
+	}
+
+	var exact = binarysearch.FloatBisect(0, 2, 0, func(x float64) bool {
+		return x*x >= 2
+	})
+	if exact != math.Nextafter(math.Sqrt2, 3) && exact != math.Sqrt2 {
+		t.Errorf("Expected full precision around %v, got %v", math.Sqrt2, exact)
+	}
+
+	if got := binarysearch.FloatBisect(0, 1, 1e-6, func(float64) bool { return false }); got != 1 {
+		t.Errorf("Expected hi when the predicate is never true, got %v", got)
+	}
+	if got := binarysearch.FloatBisect(0, 1, 1e-6, func(float64) bool { return true }); got > 1e-6 {
+		t.Errorf("Expected lo within eps when the predicate is always true, got %v", got)
+	}
+	if got := binarysearch.FloatBisect(3, 3, 1e-6, func(float64) bool { return true }); got != 3 {
+		t.Errorf("Expected 3 for an empty range, got %v", got)
+	}
+
+	var atLeast5 = func(x float64) bool { return x >= 5 }
+	for _, bounds := range [][2]float64{{0, math.Inf(1)}, {math.Inf(-1), 10}, {math.Inf(-1), math.Inf(1)}} {
+		if got := binarysearch.FloatBisect(bounds[0], bounds[1], 1e-9, atLeast5); got < 5 || got-5 > 1e-9 {
+			t.Errorf("Expected 5 within [%v, %v], got %v", bounds[0], bounds[1], got)
+		}
+	}
+	if got := binarysearch.FloatBisect(0, math.Inf(1), 1e-9, func(float64) bool { return false }); !math.IsInf(got, 1) {
+		t.Errorf("Expected +Inf when the predicate is never true, got %v", got)
+	}
+	if got := binarysearch.FloatBisect(0, 10, math.NaN(), atLeast5); got != 5 && got != math.Nextafter(5, 6) {
+		t.Errorf("Expected full precision around 5 for a NaN eps, got %v", got)
+	}
+}
+
+func TestPartitionPoint(t *testing.T) {
+	var tests = []struct {
+		arr  []int
+		want int
+	}{
+		{nil, 0},
+		{[]int{1}, 1},
+		{[]int{6}, 0},
+		{[]int{1, 2, 3}, 3},
+		{[]int{6, 7, 8}, 0},
+		{[]int{1, 2, 3, 6, 7}, 3},
+	}
+	for _, test := range tests {
+		var got = binarysearch.PartitionPoint(test.arr, func(v int) bool {
+			return v < 5
+		})
+		if got != test.want {
+			t.Errorf("PartitionPoint(%v): expected %d, got %d", test.arr, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package binarysearch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Nigel2392/go-datastructures"
+	"golang.org/x/exp/slices"
+)
+
+// A set of ordered values, stored in a sorted slice.
+//
+// Lookups are binary searches over contiguous memory,
+// which makes this a good fit for small or read-heavy sets.
+//
+// Inserting and deleting shift the elements after the position, which is O(n).
+//
+// The zero value is an empty set ready to use.
+type SortedSlice[T datastructures.Ordered] struct {
+	items []T
+}
+
+// Create a new sorted slice from the given values.
+//
+// The values are copied, sorted and deduplicated.
+func NewSortedSlice[T datastructures.Ordered](values ...T) *SortedSlice[T] {
+	var items = make([]T, len(values))
+	copy(items, values)
+	slices.Sort(items)
+	return &SortedSlice[T]{
+		items: slices.Compact(items),
+	}
+}
+
+// Returns the number of values in the sorted slice.
+func (s *SortedSlice[T]) Len() int {
+	return len(s.items)
+}
+
+// Insert a value into the sorted slice.
+//
+// Returns false if the value was already present.
+func (s *SortedSlice[T]) Insert(value T) (inserted bool) {
+	var i, found = InsertionPoint(s.items, value)
+	if found {
+		return false
+	}
+	s.items = slices.Insert(s.items, i, value)
+	return true
+}
+
+// Delete a value from the sorted slice.
+func (s *SortedSlice[T]) Delete(value T) (deleted bool) {
+	var i, found = InsertionPoint(s.items, value)
+	if !found {
+		return false
+	}
+	s.items = slices.Delete(s.items, i, i+1)
+	return true
+}
+
+// Delete all values for which the predicate returns true.
+//
+// Returns the number of values deleted.
+func (s *SortedSlice[T]) DeleteIf(predicate func(T) bool) (deleted int) {
+	var kept = s.items[:0]
+	for _, v := range s.items {
+		if predicate(v) {
+			deleted++
+		} else {
+			kept = append(kept, v)
+		}
+	}
+	// clear the tail so removed values can be garbage collected
+	var zero T
+	for i := len(kept); i < len(s.items); i++ {
+		s.items[i] = zero
+	}
+	s.items = kept
+	return deleted
+}
+
+// Report whether the value is present in the sorted slice.
+func (s *SortedSlice[T]) Contains(value T) bool {
+	var _, found = InsertionPoint(s.items, value)
+	return found
+}
+
+// Returns the index of the value, or -1 if it is not present.
+func (s *SortedSlice[T]) Index(value T) int {
+	return SearchOrdered(s.items, value)
+}
+
+// Returns the value at the given index.
+//
+// Panics if the index is out of range.
+func (s *SortedSlice[T]) At(i int) T {
+	return s.items[i]
+}
+
+// Merge the values of another sorted slice into this one.
+//
+// This is a single linear pass over both slices.
+//
+// Returns the number of values which were not yet present.
+func (s *SortedSlice[T]) Merge(other *SortedSlice[T]) (added int) {
+	if other == nil || len(other.items) == 0 {
+		return 0
+	}
+
+	var (
+		a      = s.items
+		b      = other.items
+		merged = make([]T, 0, len(a)+len(b))
+		i, j   int
+	)
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			merged = append(merged, a[i])
+			i++
+		case b[j] < a[i]:
+			merged = append(merged, b[j])
+			j++
+			added++
+		default:
+			merged = append(merged, a[i])
+			i++
+			j++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	merged = append(merged, b[j:]...)
+	added += len(b) - j
+
+	s.items = merged
+	return added
+}
+
+// Call f for every value in [from, to), in ascending order.
+//
+// Iteration stops when f returns false.
+func (s *SortedSlice[T]) Range(from, to T, f func(T) (continueLoop bool)) {
+	var start = LowerBound(s.items, from)
+	for i := start; i < len(s.items) && s.items[i] < to; i++ {
+		if !f(s.items[i]) {
+			return
+		}
+	}
+}
+
+// Traverse the sorted slice in ascending order.
+func (s *SortedSlice[T]) Traverse(f func(T)) {
+	for _, v := range s.items {
+		f(v)
+	}
+}
+
+// Returns the smallest value, and false if the sorted slice is empty.
+func (s *SortedSlice[T]) Min() (v T, ok bool) {
+	if len(s.items) == 0 {
+		return
+	}
+	return s.items[0], true
+}
+
+// Returns the largest value, and false if the sorted slice is empty.
+func (s *SortedSlice[T]) Max() (v T, ok bool) {
+	if len(s.items) == 0 {
+		return
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Returns a copy of the values as a slice.
+func (s *SortedSlice[T]) ToSlice() []T {
+	if len(s.items) == 0 {
+		return nil
+	}
+	return slices.Clone(s.items)
+}
+
+// Clear the sorted slice.
+func (s *SortedSlice[T]) Clear() {
+	s.items = nil
+}
+
+// Returns the sorted slice as a string.
+func (s *SortedSlice[T]) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	for i, v := range s.items {
+		fmt.Fprintf(&b, "%v", v)
+		if i != len(s.items)-1 {
+			b.WriteString(", ")
+		}
+	}
+	b.WriteString("]")
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package binarysearch_test
+
+import (
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/binarysearch"
+)
+
+func TestSortedSlice(t *testing.T) {
+	var s = binarysearch.NewSortedSlice(5, 3, 9, 3, 1)
+	if s.String() != "[1, 3, 5, 9]" {
+		t.Fatalf("Expected [1, 3, 5, 9], got %s", s)
+	}
+
+	if !s.Insert(4) || s.Insert(4) {
+		t.Fatal("Expected 4 to be inserted exactly once")
+	}
+	if !s.Insert(0) || !s.Insert(10) {
+		t.Fatal("Expected 0 and 10 to be inserted")
+	}
+	if s.String() != "[0, 1, 3, 4, 5, 9, 10]" {
+		t.Fatalf("Expected [0, 1, 3, 4, 5, 9, 10], got %s", s)
+	}
+
+	for _, v := range []int{0, 1, 3, 4, 5, 9, 10} {
+		if !s.Contains(v) {
+			t.Fatalf("Expected %d to be present", v)
+		}
+		if s.At(s.Index(v)) != v {
+			t.Fatalf("Expected At(Index(%d)) to be %d", v, v)
+		}
+	}
+	if s.Contains(2) || s.Index(2) != -1 {
+		t.Fatal("Expected 2 not to be present")
+	}
+
+	if !s.Delete(4) || s.Delete(4) {
+		t.Fatal("Expected 4 to be deleted exactly once")
+	}
+	if s.Len() != 6 {
+		t.Fatalf("Expected length 6, got %d", s.Len())
+	}
+
+	if min, ok := s.Min(); !ok || min != 0 {
+		t.Fatalf("Expected min 0, got %d", min)
+	}
+	if max, ok := s.Max(); !ok || max != 10 {
+		t.Fatalf("Expected max 10, got %d", max)
+	}
+
+	if deleted := s.DeleteIf(func(v int) bool { return v > 5 }); deleted != 2 {
+		t.Fatalf("Expected 2 values deleted, got %d", deleted)
+	}
+	if s.String() != "[0, 1, 3, 5]" {
+		t.Fatalf("Expected [0, 1, 3, 5], got %s", s)
+	}
+
+	s.Clear()
+	if s.Len() != 0 || s.ToSlice() != nil {
+		t.Fatal("Expected an empty sorted slice after Clear")
+	}
+	if _, ok := s.Min(); ok {
+		t.Fatal("Expected no min for an empty sorted slice")
+	}
+}
+
+func TestSortedSliceZeroValue(t *testing.T) {
+	var s binarysearch.SortedSlice[string]
+	if s.Contains("a") || s.Delete("a") {
+		t.Fatal("Expected an empty sorted slice")
+	}
+	s.Insert("b")
+	s.Insert("a")
+	if s.String() != "[a, b]" {
+		t.Fatalf("Expected [a, b], got %s", s.String())
+	}
+}
+
+func TestSortedSliceMerge(t *testing.T) {
+	var a = binarysearch.NewSortedSlice(1, 3, 5, 7)
+	var b = binarysearch.NewSortedSlice(0, 3, 4, 7, 8, 9)
+	if added := a.Merge(b); added != 4 {
+		t.Fatalf("Expected 4 values added, got %d", added)
+	}
+	if a.String() != "[0, 1, 3, 4, 5, 7, 8, 9]" {
+		t.Fatalf("Expected [0, 1, 3, 4, 5, 7, 8, 9], got %s", a)
+	}
+	if b.Len() != 6 {
+		t.Fatalf("Expected the merged slice to be untouched, got %s", b)
+	}
+	if added := a.Merge(nil); added != 0 {
+		t.Fatalf("Expected 0 values added, got %d", added)
+	}
+
+	var empty binarysearch.SortedSlice[int]
+	if added := empty.Merge(b); added != b.Len() {
+		t.Fatalf("Expected %d values added, got %d", b.Len(), added)
+	}
+}
+
+func TestSortedSliceRange(t *testing.T) {
+	var s = binarysearch.NewSortedSlice(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	var got []int
+	s.Range(3, 7, func(v int) bool {
+		got = append(got, v)
+		return true
+	})
+	if len(got) != 4 || got[0] != 3 || got[3] != 6 {
+		t.Fatalf("Expected [3 4 5 6], got %v", got)
+	}
+
+	got = got[:0]
+	s.Range(0, 100, func(v int) bool {
+		got = append(got, v)
+		return v < 2
+	})
+	if len(got) != 2 {
+		t.Fatalf("Expected iteration to stop after 2 values, got %v", got)
+	}
+
+	s.Range(7, 3, func(v int) bool {
+		t.Fatalf("Expected an empty range, got %d", v)
+		return false
+	})
+}
@@ -0,0 +1,202 @@
+package binarysearch
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/Nigel2392/go-datastructures"
+)
+
+// Number is an ordered type which supports arithmetic.
+//
+// It is datastructures.Ordered without strings.
+type Number interface {
+	~int | ~uint |
+		~int8 | ~int16 | ~int32 | ~int64 |
+		~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Exponential search over a sorted slice.
+//
+// Doubles the search bound until it passes the value, then bisects the last interval.
+//
+// This is faster than Search when the value is close to the start of the slice.
+//
+// Returns the index of the value, or -1 if it is not present.
+func ExponentialSearch[T datastructures.Ordered](arr []T, val T) int {
+	return ExponentialSearchSource(func(i int) (v T, ok bool) {
+		if i >= len(arr) {
+			return
+		}
+		return arr[i], true
+	}, val)
+}
+
+// Exponential search over a sorted source of unknown length.
+//
+// The source returns the value at the given index,
+// and false if the index is past the end of the source.
+//
+// Only indices up to twice the position of the value are requested,
+// which makes this suitable for cursor-backed or unbounded sources.
+//
+// Returns the index of the value, or -1 if it is not present.
+func ExponentialSearchSource[T datastructures.Ordered](at func(i int) (T, bool), val T) int {
+	var bound = 1
+	for {
+		var v, ok = at(bound - 1)
+		if !ok || v >= val {
+			break
+		}
+		if bound > maxInt/2 {
+			bound = maxInt
+			break
+		}
+		bound *= 2
+	}
+
+	var i = FirstTrue(bound/2, bound, func(i int) bool {
+		var v, ok = at(i)
+		return !ok || v >= val
+	})
+	if v, ok := at(i); ok && v == val {
+		return i
+	}
+	return -1
+}
+
+// Interpolation search over a sorted slice of numbers.
+//
+// Probes where the value is expected to be, assuming the values are uniformly distributed.
+//
+// This takes O(log log n) probes on uniform data, but can degrade to O(n) on skewed data.
+//
+// Returns the index of the value, or -1 if it is not present.
+func InterpolationSearch[T Number](arr []T, val T) int {
+	var lo, hi = 0, len(arr) - 1
+	for lo <= hi && val >= arr[lo] && val <= arr[hi] {
+		if arr[hi] == arr[lo] {
+			if arr[lo] == val {
+				return lo
+			}
+			return -1
+		}
+
+		var (
+			span = float64(arr[hi]) - float64(arr[lo])
+			dist = float64(val) - float64(arr[lo])
+			pos  int
+		)
+		if math.IsInf(span, 0) || math.IsNaN(span) {
+			// The window holds an infinity, so there is nothing to interpolate: bisect instead.
+			pos = lo + (hi-lo)/2
+		} else {
+			pos = lo + int(float64(hi-lo)*(dist/span))
+		}
+		// Rounding can put the probe just outside the window.
+		if pos < lo {
+			pos = lo
+		} else if pos > hi {
+			pos = hi
+		}
+
+		switch {
+		case arr[pos] == val:
+			return pos
+		case arr[pos] < val:
+			lo = pos + 1
+		default:
+			hi = pos - 1
+		}
+	}
+	return -1
+}
+
+// A sorted slice in Eytzinger (breadth-first) layout.
+//
+// The values are laid out like an implicit binary tree,
+// so the first levels of every search share the same cache lines
+// and the search loop has no unpredictable branches.
+//
+// Build it once with NewEytzinger, then search it many times.
+type Eytzinger[T datastructures.Ordered] struct {
+	// data[0] is unused, the children of k are 2k and 2k+1.
+	data []T
+	// index of each value in the original sorted slice.
+	index []int
+}
+
+// Lay out a sorted slice in Eytzinger order.
+//
+// The slice is not modified.
+func NewEytzinger[T datastructures.Ordered](sorted []T) *Eytzinger[T] {
+	var e = &Eytzinger[T]{
+		data:  make([]T, len(sorted)+1),
+		index: make([]int, len(sorted)+1),
+	}
+	e.build(sorted, 1, 0)
+	return e
+}
+
+func (e *Eytzinger[T]) build(sorted []T, k, i int) int {
+	if k <= len(sorted) {
+		i = e.build(sorted, 2*k, i)
+		e.data[k] = sorted[i]
+		e.index[k] = i
+		i++
+		i = e.build(sorted, 2*k+1, i)
+	}
+	return i
+}
+
+// Returns the number of values.
+func (e *Eytzinger[T]) Len() int {
+	return len(e.data) - 1
+}
+
+// Returns the index in the original sorted slice of the first value
+// which is not less than the given value.
+//
+// Returns Len() if all values are less than the given value.
+func (e *Eytzinger[T]) LowerBound(val T) int {
+	var k = e.lowerBound(val)
+	if k == 0 {
+		return e.Len()
+	}
+	return e.index[k]
+}
+
+// Search for a value.
+//
+// Returns the index in the original sorted slice, or -1 if it is not present.
+func (e *Eytzinger[T]) Search(val T) int {
+	var k = e.lowerBound(val)
+	if k == 0 || e.data[k] != val {
+		return -1
+	}
+	return e.index[k]
+}
+
+func (e *Eytzinger[T]) lowerBound(val T) int {
+	var (
+		k = 1
+		n = len(e.data) - 1
+	)
+	for k <= n {
+		k = 2*k + lessInt(e.data[k], val)
+	}
+	// Every right turn after the last left turn is undone by
+	// stripping the trailing ones and the left turn itself.
+	return k >> (bits.TrailingZeros(^uint(k)) + 1)
+}
+
+// Kept separate so the compiler emits a conditional set instead of a branch.
+func lessInt[T datastructures.Ordered](a, b T) int {
+	if a < b {
+		return 1
+	}
+	return 0
+}
+
+const maxInt = int(^uint(0) >> 1)
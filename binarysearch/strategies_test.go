@@ -0,0 +1,171 @@
+package binarysearch_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/binarysearch"
+)
+
+func TestExponentialSearch(t *testing.T) {
+	for _, v := range sorted {
+		var idx = binarysearch.ExponentialSearch(sorted, v)
+		if idx == -1 || sorted[idx] != v {
+			t.Fatalf("Expected to find %d, got index %d", v, idx)
+		}
+	}
+	for _, v := range []int{0, 2, 4, 6, 8, 10, 12} {
+		if idx := binarysearch.ExponentialSearch(sorted, v); idx != -1 {
+			t.Fatalf("Expected %d not to be found, got index %d", v, idx)
+		}
+	}
+	if idx := binarysearch.ExponentialSearch([]int{}, 1); idx != -1 {
+		t.Fatalf("Expected -1 for an empty slice, got %d", idx)
+	}
+}
+
+func TestExponentialSearchSource(t *testing.T) {
+	// An unbounded source of even numbers.
+	var maxIndex int
+	var evens = func(i int) (int, bool) {
+		if i > maxIndex {
+			maxIndex = i
+		}
+		return i * 2, true
+	}
+
+	if idx := binarysearch.ExponentialSearchSource(evens, 2000); idx != 1000 {
+		t.Fatalf("Expected 1000, got %d", idx)
+	}
+	if maxIndex >= 2048 {
+		t.Fatalf("Expected at most 2048 indices to be probed, got %d", maxIndex)
+	}
+	if idx := binarysearch.ExponentialSearchSource(evens, 2001); idx != -1 {
+		t.Fatalf("Expected -1, got %d", idx)
+	}
+	if idx := binarysearch.ExponentialSearchSource(evens, 0); idx != 0 {
+		t.Fatalf("Expected 0, got %d", idx)
+	}
+}
+
+func TestInterpolationSearch(t *testing.T) {
+	for _, v := range sorted {
+		var idx = binarysearch.InterpolationSearch(sorted, v)
+		if idx == -1 || sorted[idx] != v {
+			t.Fatalf("Expected to find %d, got index %d", v, idx)
+		}
+	}
+	for _, v := range []int{0, 2, 4, 6, 8, 10, 12} {
+		if idx := binarysearch.InterpolationSearch(sorted, v); idx != -1 {
+			t.Fatalf("Expected %d not to be found, got index %d", v, idx)
+		}
+	}
+	if idx := binarysearch.InterpolationSearch([]float64{}, 1); idx != -1 {
+		t.Fatalf("Expected -1 for an empty slice, got %d", idx)
+	}
+	if idx := binarysearch.InterpolationSearch([]uint8{4, 4, 4}, 4); idx == -1 {
+		t.Fatal("Expected to find 4 in a constant slice")
+	}
+	if idx := binarysearch.InterpolationSearch([]uint{1, 2, 1000}, 3); idx != -1 {
+		t.Fatalf("Expected -1, got %d", idx)
+	}
+
+	var inf = []float64{math.Inf(-1), -5, 0, 1, 7, math.Inf(1)}
+	for i, v := range inf {
+		if idx := binarysearch.InterpolationSearch(inf, v); idx != i {
+			t.Fatalf("Expected %v at index %d, got %d", v, i, idx)
+		}
+	}
+	for _, v := range []float64{-6, 0.5, 8, math.NaN()} {
+		if idx := binarysearch.InterpolationSearch(inf, v); idx != -1 {
+			t.Fatalf("Expected %v not to be found, got index %d", v, idx)
+		}
+	}
+	if idx := binarysearch.InterpolationSearch([]float64{math.Inf(-1), 0, 1}, 0); idx != 1 {
+		t.Fatalf("Expected 0 at index 1, got %d", idx)
+	}
+}
+
+func TestEytzinger(t *testing.T) {
+	for n := 0; n < 64; n++ {
+		var arr = make([]int, n)
+		for i := range arr {
+			arr[i] = i * 2
+		}
+		var e = binarysearch.NewEytzinger(arr)
+		if e.Len() != n {
+			t.Fatalf("Expected length %d, got %d", n, e.Len())
+		}
+		for v := -1; v <= n*2; v++ {
+			var want = binarysearch.SearchOrdered(arr, v)
+			if idx := e.Search(v); idx != want {
+				t.Fatalf("n=%d: Search(%d): expected %d, got %d", n, v, want, idx)
+			}
+			want = binarysearch.LowerBound(arr, v)
+			if idx := e.LowerBound(v); idx != want {
+				t.Fatalf("n=%d: LowerBound(%d): expected %d, got %d", n, v, want, idx)
+			}
+		}
+	}
+}
+
+const benchSize = 1 << 20
+
+func benchData() (arr []int, targets []int) {
+	var r = rand.New(rand.NewSource(1))
+	arr = make([]int, benchSize)
+	for i := range arr {
+		arr[i] = i * 3
+	}
+	targets = make([]int, 1<<12)
+	for i := range targets {
+		targets[i] = r.Intn(benchSize * 3)
+	}
+	return arr, targets
+}
+
+func BenchmarkSearch(b *testing.B) {
+	var arr, targets = benchData()
+	var cmp = make([]cmpInt, len(arr))
+	for i, v := range arr {
+		cmp[i] = cmpInt(v)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		binarysearch.Search(cmp, cmpInt(targets[i%len(targets)]))
+	}
+}
+
+func BenchmarkSearchOrdered(b *testing.B) {
+	var arr, targets = benchData()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		binarysearch.SearchOrdered(arr, targets[i%len(targets)])
+	}
+}
+
+func BenchmarkExponentialSearch(b *testing.B) {
+	var arr, targets = benchData()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		binarysearch.ExponentialSearch(arr, targets[i%len(targets)])
+	}
+}
+
+func BenchmarkInterpolationSearch(b *testing.B) {
+	var arr, targets = benchData()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		binarysearch.InterpolationSearch(arr, targets[i%len(targets)])
+	}
+}
+
+func BenchmarkEytzinger(b *testing.B) {
+	var arr, targets = benchData()
+	var e = binarysearch.NewEytzinger(arr)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		e.Search(targets[i%len(targets)])
+	}
+}
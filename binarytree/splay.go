@@ -0,0 +1,209 @@
+package binarytree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Nigel2392/go-datastructures"
+)
+
+// The height from which String stops widening the padding between values.
+const maxPaddedHeight = 6
+
+// A splay tree implementation.
+//
+// Every Search, Insert and Delete splays the accessed value to the root,
+// so recently accessed values are found faster than in a plain BST.
+//
+// Because searching restructures the tree, Search is not safe to call concurrently.
+type Splay[T datastructures.Ordered] struct {
+	root *SplayNode[T]
+	len  int
+}
+
+// Return the splay tree as a string.
+func (t *Splay[T]) String() string {
+	if t.root == nil {
+		return ""
+	}
+
+	height := t.root.getHeight()
+	SplayNodes := make([][]string, height)
+
+	fillSplayNodes(SplayNodes, t.root, 0)
+
+	var b strings.Builder
+	// The padding doubles with every level, so deep trees,
+	// like the one built from sorted inserts, are laid out as if they were maxPaddedHeight high.
+	padding := 1<<minInt(height, maxPaddedHeight) - 1
+
+	for i, level := range SplayNodes {
+		if i == 0 {
+			paddingStr := strings.Repeat(" ", (padding/2)+1)
+			b.WriteString(paddingStr)
+		} else {
+			paddingStr := strings.Repeat(" ", padding/2)
+			b.WriteString(paddingStr)
+		}
+
+		for j, SplayNode := range level {
+			b.WriteString(SplayNode)
+			if j != len(level)-1 {
+				b.WriteString(strings.Repeat(" ", maxInt(padding, 1)))
+			}
+		}
+
+		padding /= 2
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// Initialize a new splay tree with the given initial value.
+func NewSplay[T datastructures.Ordered](initial T) *Splay[T] {
+	return &Splay[T]{
+		root: &SplayNode[T]{value: initial},
+		len:  1,
+	}
+}
+
+// Insert a new value into the splay tree.
+//
+// The inserted (or already present) value becomes the root.
+func (t *Splay[T]) Insert(value T) (inserted bool) {
+	if t.root == nil {
+		t.root = &SplayNode[T]{value: value}
+		t.len++
+		return true
+	}
+
+	t.root = t.root.splay(value)
+	if t.root.value == value {
+		return false
+	}
+
+	var n = &SplayNode[T]{value: value}
+	if value < t.root.value {
+		n.left = t.root.left
+		n.right = t.root
+		t.root.left = nil
+	} else {
+		n.right = t.root.right
+		n.left = t.root
+		t.root.right = nil
+	}
+	t.root = n
+	t.len++
+	return true
+}
+
+// Search for a value in the splay tree.
+//
+// The found value, or the last value on the search path, becomes the root.
+func (t *Splay[T]) Search(value T) (v T, ok bool) {
+	if t.root == nil {
+		return
+	}
+	t.root = t.root.splay(value)
+	if t.root.value != value {
+		return
+	}
+	return t.root.value, true
+}
+
+// Delete a value from the splay tree.
+func (t *Splay[T]) Delete(value T) (deleted bool) {
+	if t.root == nil {
+		return false
+	}
+
+	t.root = t.root.splay(value)
+	if t.root.value != value {
+		return false
+	}
+
+	if t.root.left == nil {
+		t.root = t.root.right
+	} else {
+		// The maximum of the left subtree has no right child after splaying,
+		// so the right subtree can be attached to it directly.
+		var right = t.root.right
+		t.root = t.root.left.splay(value)
+		t.root.right = right
+	}
+	t.len--
+	return true
+}
+
+// Delete a value from the splay tree if the predicate returns true.
+func (t *Splay[T]) DeleteIf(predicate func(T) bool) (deleted int) {
+	if t.root == nil {
+		return 0
+	}
+	var matched []T
+	t.root.traverse(func(v T) {
+		if predicate(v) {
+			matched = append(matched, v)
+		}
+	})
+	for _, v := range matched {
+		if t.Delete(v) {
+			deleted++
+		}
+	}
+	return deleted
+}
+
+// Traverse the splay tree in order.
+//
+// Traversing does not splay the tree.
+func (t *Splay[T]) Traverse(f func(T)) {
+	if t.root == nil {
+		return
+	}
+	t.root.traverse(f)
+}
+
+// Return the number of values in the splay tree.
+func (t *Splay[T]) Len() int {
+	return t.len
+}
+
+// Return the height of the splay tree.
+func (t *Splay[T]) Height() int {
+	if t.root == nil {
+		return 0
+	}
+	return t.root.getHeight()
+}
+
+// Clear the splay tree.
+func (t *Splay[T]) Clear() {
+	t.root = nil
+	t.len = 0
+}
+
+func fillSplayNodes[T datastructures.Ordered](SplayNodes [][]string, n *SplayNode[T], depth int) {
+	if n == nil {
+		return
+	}
+
+	SplayNodes[depth] = append(SplayNodes[depth], fmt.Sprintf("%v", n.value))
+	fillSplayNodes(SplayNodes, n.left, depth+1)
+	fillSplayNodes(SplayNodes, n.right, depth+1)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,104 @@
+package binarytree
+
+import "github.com/Nigel2392/go-datastructures"
+
+type SplayNode[T datastructures.Ordered] struct {
+	value T
+	left  *SplayNode[T]
+	right *SplayNode[T]
+}
+
+func (n *SplayNode[T]) Value() T {
+	return n.value
+}
+
+// Top-down splay.
+//
+// Brings the node with the given value to the root of the subtree.
+//
+// If the value is not present, the last node visited on the search path becomes the root.
+func (n *SplayNode[T]) splay(v T) *SplayNode[T] {
+	if n == nil {
+		return nil
+	}
+
+	var (
+		header SplayNode[T]
+		left   = &header
+		right  = &header
+	)
+
+	for {
+		if v < n.value {
+			if n.left == nil {
+				break
+			}
+			if v < n.left.value {
+				// rotate right
+				var y = n.left
+				n.left = y.right
+				y.right = n
+				n = y
+				if n.left == nil {
+					break
+				}
+			}
+			// link right
+			right.left = n
+			right = n
+			n = n.left
+		} else if v > n.value {
+			if n.right == nil {
+				break
+			}
+			if v > n.right.value {
+				// rotate left
+				var y = n.right
+				n.right = y.left
+				y.left = n
+				n = y
+				if n.right == nil {
+					break
+				}
+			}
+			// link left
+			left.right = n
+			left = n
+			n = n.right
+		} else {
+			break
+		}
+	}
+
+	// assemble
+	left.right = n.left
+	right.left = n.right
+	n.left = header.right
+	n.right = header.left
+	return n
+}
+
+func (n *SplayNode[T]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+
+	leftHeight := n.left.getHeight()
+	rightHeight := n.right.getHeight()
+
+	if leftHeight > rightHeight {
+		return leftHeight + 1
+	}
+
+	return rightHeight + 1
+}
+
+func (n *SplayNode[T]) traverse(f func(T)) {
+	if n == nil {
+		return
+	}
+
+	n.left.traverse(f)
+	f(n.value)
+	n.right.traverse(f)
+}
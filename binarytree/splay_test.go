@@ -0,0 +1,223 @@
+package binarytree_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/binarytree"
+)
+
+func TestSplay(t *testing.T) {
+	var tree = new(binarytree.Splay[int])
+	var values = rand.New(rand.NewSource(1)).Perm(1000)
+	for _, v := range values {
+		if !tree.Insert(v) {
+			t.Fatalf("Expected %d to be inserted", v)
+		}
+	}
+
+	if tree.Insert(values[0]) {
+		t.Fatalf("Expected duplicate %d not to be inserted", values[0])
+	}
+
+	if tree.Len() != len(values) {
+		t.Fatalf("Expected length %d, got %d", len(values), tree.Len())
+	}
+
+	for _, v := range values {
+		if found, ok := tree.Search(v); !ok || found != v {
+			t.Fatalf("Expected to find %d, got %d (%v)", v, found, ok)
+		}
+	}
+
+	if _, ok := tree.Search(-1); ok {
+		t.Fatal("Expected -1 not to be found")
+	}
+
+	var prev = -1
+	tree.Traverse(func(v int) {
+		if v <= prev {
+			t.Fatalf("Expected in-order traversal, got %d after %d", v, prev)
+		}
+		prev = v
+	})
+
+	for _, v := range values[:500] {
+		if !tree.Delete(v) {
+			t.Fatalf("Expected %d to be deleted", v)
+		}
+		if tree.Delete(v) {
+			t.Fatalf("Expected %d to be deleted only once", v)
+		}
+	}
+
+	if tree.Len() != 500 {
+		t.Fatalf("Expected length 500, got %d", tree.Len())
+	}
+
+	for _, v := range values[:500] {
+		if _, ok := tree.Search(v); ok {
+			t.Fatalf("Expected %d to be deleted", v)
+		}
+	}
+
+	var deleted = tree.DeleteIf(func(v int) bool {
+		return v%2 == 0
+	})
+	var count int
+	tree.Traverse(func(v int) {
+		if v%2 == 0 {
+			t.Fatalf("Expected %d to be deleted by DeleteIf", v)
+		}
+		count++
+	})
+
+	if count != tree.Len() || 500-deleted != tree.Len() {
+		t.Fatalf("Expected length %d, got %d (traversed %d)", 500-deleted, tree.Len(), count)
+	}
+}
+
+func TestSplayAccessedValueIsRoot(t *testing.T) {
+	var tree = binarytree.NewSplay(5)
+	for i := 0; i < 10; i++ {
+		tree.Insert(i)
+	}
+
+	// After splaying, the accessed value is the root
+	// and therefore the first value printed.
+	tree.Search(7)
+	var height = tree.Height()
+	if height == 0 {
+		t.Fatal("Expected a non-empty tree")
+	}
+	var str = tree.String()
+	var i = 0
+	for i < len(str) && str[i] == ' ' {
+		i++
+	}
+	if str[i:i+1] != "7" || str[i+1] != '\n' {
+		t.Fatalf("Expected 7 to be the root, got:\n%s", str)
+	}
+}
+
+func TestSplayStringSequential(t *testing.T) {
+	var tree = binarytree.NewSplay(0)
+	for i := 1; i < 100; i++ {
+		tree.Insert(i)
+	}
+	if tree.Height() < 64 {
+		t.Fatalf("Expected sorted inserts to build a deep tree, got height %d", tree.Height())
+	}
+	var str = tree.String()
+	var lines = strings.Split(strings.TrimSuffix(str, "\n"), "\n")
+	if len(lines) != tree.Height() {
+		t.Fatalf("Expected %d lines, got %d", tree.Height(), len(lines))
+	}
+	if len(str) > 100*100 {
+		t.Fatalf("Expected a compact layout, got %d bytes", len(str))
+	}
+	if strings.TrimSpace(lines[0]) != "99" || strings.TrimSpace(lines[len(lines)-1]) != "0" {
+		t.Fatalf("Expected 99 at the root and 0 at the bottom, got %q and %q", lines[0], lines[len(lines)-1])
+	}
+}
+
+type cmpInt int
+
+func (a cmpInt) Lt(b cmpInt) bool {
+	return a < b
+}
+
+const (
+	zipfKeys     = 1 << 16
+	zipfAccesses = 1 << 16
+)
+
+// Keys are inserted in random order, the accessed keys are drawn from a Zipfian distribution
+// and mapped through a permutation so the hot keys are scattered across the key space.
+func zipfWorkload() (inserts []int, accesses []int) {
+	var r = rand.New(rand.NewSource(42))
+	inserts = r.Perm(zipfKeys)
+	var hot = r.Perm(zipfKeys)
+	var zipf = rand.NewZipf(r, 1.1, 1, zipfKeys-1)
+	accesses = make([]int, zipfAccesses)
+	for i := range accesses {
+		accesses[i] = hot[zipf.Uint64()]
+	}
+	return inserts, accesses
+}
+
+func BenchmarkSearchZipf_Splay(b *testing.B) {
+	var inserts, accesses = zipfWorkload()
+	var tree = new(binarytree.Splay[int])
+	for _, v := range inserts {
+		tree.Insert(v)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Search(accesses[i%len(accesses)])
+	}
+}
+
+func BenchmarkSearchZipf_BST(b *testing.B) {
+	var inserts, accesses = zipfWorkload()
+	var tree = binarytree.NewBST(inserts[0])
+	for _, v := range inserts[1:] {
+		tree.Insert(v)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Search(accesses[i%len(accesses)])
+	}
+}
+
+// SliceToBST builds a perfectly balanced tree.
+func BenchmarkSearchZipf_BalancedBST(b *testing.B) {
+	var inserts, accesses = zipfWorkload()
+	var tree = binarytree.SliceToBST(inserts, false)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Search(accesses[i%len(accesses)])
+	}
+}
+
+func BenchmarkSearchZipf_BalancedInterfacedBST(b *testing.B) {
+	var inserts, accesses = zipfWorkload()
+	var items = make([]cmpInt, len(inserts))
+	for i, v := range inserts {
+		items[i] = cmpInt(v)
+	}
+	var tree = binarytree.SliceToInterfacedBST(items, false)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Search(cmpInt(accesses[i%len(accesses)]))
+	}
+}
+
+func BenchmarkInsertDeleteZipf_Splay(b *testing.B) {
+	var inserts, accesses = zipfWorkload()
+	var tree = new(binarytree.Splay[int])
+	for _, v := range inserts {
+		tree.Insert(v)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var v = accesses[i%len(accesses)]
+		tree.Delete(v)
+		tree.Insert(v)
+	}
+}
+
+func BenchmarkInsertDeleteZipf_BST(b *testing.B) {
+	var inserts, accesses = zipfWorkload()
+	var tree = binarytree.NewBST(inserts[0])
+	for _, v := range inserts[1:] {
+		tree.Insert(v)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var v = accesses[i%len(accesses)]
+		tree.Delete(v)
+		tree.Insert(v)
+	}
+}
@@ -0,0 +1,312 @@
+package deque
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The capacity a deque starts with, and never shrinks below.
+const minCap = 16
+
+// A double-ended queue backed by a ring buffer.
+//
+// Pushing and popping at either end is amortized O(1) and does not allocate per value.
+// The buffer's capacity is always a power of two, it doubles when full
+// and halves once less than a quarter of it is in use.
+//
+// Insert and Remove in the middle shift the values on the shorter side.
+//
+// The zero value is an empty deque, ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+// Create a new deque with room for at least capacity values.
+func New[T any](capacity int) *Deque[T] {
+	var c = minCap
+	for c < capacity {
+		c <<= 1
+	}
+	return &Deque[T]{
+		buf: make([]T, c),
+	}
+}
+
+// Returns the number of values in the deque.
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+// Returns the number of values the deque can hold before it grows.
+func (d *Deque[T]) Cap() int {
+	return len(d.buf)
+}
+
+// Returns the deque as a string, from front to back.
+func (d *Deque[T]) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	for i := 0; i < d.len; i++ {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%v", d.buf[d.index(i)])
+	}
+	b.WriteString("]")
+	return b.String()
+}
+
+// Add a value to the front of the deque.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = d.wrap(d.head - 1)
+	d.buf[d.head] = v
+	d.len++
+}
+
+// Add a value to the back of the deque.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[d.index(d.len)] = v
+	d.len++
+}
+
+// Remove a value from the front of the deque.
+//
+// Returns false if the deque is empty.
+func (d *Deque[T]) PopFront() (v T, ok bool) {
+	if d.len == 0 {
+		return
+	}
+	var zero T
+	v, d.buf[d.head] = d.buf[d.head], zero
+	d.head = d.wrap(d.head + 1)
+	d.len--
+	d.shrink()
+	return v, true
+}
+
+// Remove a value from the back of the deque.
+//
+// Returns false if the deque is empty.
+func (d *Deque[T]) PopBack() (v T, ok bool) {
+	if d.len == 0 {
+		return
+	}
+	var i = d.index(d.len - 1)
+	var zero T
+	v, d.buf[i] = d.buf[i], zero
+	d.len--
+	d.shrink()
+	return v, true
+}
+
+// Returns the value at the front of the deque.
+//
+// Returns false if the deque is empty.
+func (d *Deque[T]) Front() (v T, ok bool) {
+	if d.len == 0 {
+		return
+	}
+	return d.buf[d.head], true
+}
+
+// Returns the value at the back of the deque.
+//
+// Returns false if the deque is empty.
+func (d *Deque[T]) Back() (v T, ok bool) {
+	if d.len == 0 {
+		return
+	}
+	return d.buf[d.index(d.len-1)], true
+}
+
+// Returns the value at a given index, counting from the front.
+//
+// Returns false if the index is out of range.
+func (d *Deque[T]) At(i int) (v T, ok bool) {
+	if i < 0 || i >= d.len {
+		return
+	}
+	return d.buf[d.index(i)], true
+}
+
+// Set the value at a given index, counting from the front.
+//
+// Returns false if the index is out of range.
+func (d *Deque[T]) Set(i int, v T) bool {
+	if i < 0 || i >= d.len {
+		return false
+	}
+	d.buf[d.index(i)] = v
+	return true
+}
+
+// Insert a value at a given index, counting from the front.
+//
+// Inserting at index Len() pushes the value to the back.
+//
+// Returns false if the index is out of range.
+func (d *Deque[T]) Insert(i int, v T) bool {
+	if i < 0 || i > d.len {
+		return false
+	}
+	d.grow()
+	if i < d.len/2 {
+		// shift the values before i one place towards the front
+		d.head = d.wrap(d.head - 1)
+		for j := 0; j < i; j++ {
+			d.buf[d.index(j)] = d.buf[d.index(j+1)]
+		}
+	} else {
+		// shift the values from i one place towards the back
+		for j := d.len; j > i; j-- {
+			d.buf[d.index(j)] = d.buf[d.index(j-1)]
+		}
+	}
+	d.buf[d.index(i)] = v
+	d.len++
+	return true
+}
+
+// Remove the value at a given index, counting from the front.
+//
+// Returns the removed value, and false if the index is out of range.
+func (d *Deque[T]) Remove(i int) (v T, ok bool) {
+	if i < 0 || i >= d.len {
+		return
+	}
+	v = d.buf[d.index(i)]
+	var zero T
+	if i < d.len/2 {
+		// shift the values before i one place towards the back
+		for j := i; j > 0; j-- {
+			d.buf[d.index(j)] = d.buf[d.index(j-1)]
+		}
+		d.buf[d.head] = zero
+		d.head = d.wrap(d.head + 1)
+	} else {
+		// shift the values after i one place towards the front
+		for j := i; j < d.len-1; j++ {
+			d.buf[d.index(j)] = d.buf[d.index(j+1)]
+		}
+		d.buf[d.index(d.len-1)] = zero
+	}
+	d.len--
+	d.shrink()
+	return v, true
+}
+
+// Rotate the deque n places.
+//
+// A positive n moves values from the front to the back,
+// so the value at index n ends up at the front.
+// A negative n moves values from the back to the front.
+func (d *Deque[T]) Rotate(n int) {
+	if d.len <= 1 {
+		return
+	}
+	n %= d.len
+	if n == 0 {
+		return
+	}
+	if d.len == len(d.buf) {
+		// The buffer is full, so moving the head is enough.
+		d.head = d.wrap(d.head + n)
+		return
+	}
+
+	// Move whichever side is shorter.
+	if n < 0 {
+		n += d.len
+	}
+	var zero T
+	if n <= d.len/2 {
+		for ; n > 0; n-- {
+			d.buf[d.index(d.len)] = d.buf[d.head]
+			d.buf[d.head] = zero
+			d.head = d.wrap(d.head + 1)
+		}
+	} else {
+		for n = d.len - n; n > 0; n-- {
+			var back = d.index(d.len - 1)
+			d.head = d.wrap(d.head - 1)
+			d.buf[d.head] = d.buf[back]
+			d.buf[back] = zero
+		}
+	}
+}
+
+// Remove all values from the deque.
+//
+// The deque keeps its current capacity.
+func (d *Deque[T]) Clear() {
+	var zero T
+	for i := 0; i < d.len; i++ {
+		d.buf[d.index(i)] = zero
+	}
+	d.head = 0
+	d.len = 0
+}
+
+// Returns the values in the deque as a slice, from front to back.
+func (d *Deque[T]) ToSlice() []T {
+	if d.len == 0 {
+		return nil
+	}
+	var slice = make([]T, d.len)
+	d.copyTo(slice)
+	return slice
+}
+
+// Returns the buffer position of index i.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+// Wraps a buffer position around the ends of the buffer.
+func (d *Deque[T]) wrap(i int) int {
+	return i & (len(d.buf) - 1)
+}
+
+// Make room for one more value, doubling the buffer if it is full.
+func (d *Deque[T]) grow() {
+	if d.len < len(d.buf) {
+		return
+	}
+	var size = len(d.buf) << 1
+	if size == 0 {
+		size = minCap
+	}
+	d.resize(size)
+}
+
+// Halve the buffer when less than a quarter of it is in use.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCap && d.len < len(d.buf)/4 {
+		d.resize(len(d.buf) >> 1)
+	}
+}
+
+func (d *Deque[T]) resize(size int) {
+	var buf = make([]T, size)
+	d.copyTo(buf)
+	d.buf = buf
+	d.head = 0
+}
+
+// Copy the values from front to back into dst, which must hold at least Len values.
+func (d *Deque[T]) copyTo(dst []T) {
+	if d.len == 0 {
+		return
+	}
+	var end = d.head + d.len
+	if end <= len(d.buf) {
+		copy(dst, d.buf[d.head:end])
+		return
+	}
+	var n = copy(dst, d.buf[d.head:])
+	copy(dst[n:], d.buf[:end-len(d.buf)])
+}
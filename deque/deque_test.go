@@ -0,0 +1,182 @@
+package deque_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/deque"
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+func TestDeque(t *testing.T) {
+	var d deque.Deque[int]
+	if _, ok := d.PopFront(); ok {
+		t.Fatal("Expected an empty deque")
+	}
+	if _, ok := d.Back(); ok {
+		t.Fatal("Expected an empty deque")
+	}
+	for i := 0; i < 5; i++ {
+		d.PushBack(i)
+	}
+	d.PushFront(-1)
+	if d.String() != "[-1, 0, 1, 2, 3, 4]" {
+		t.Fatalf("Unexpected deque: %s", d.String())
+	}
+	if v, _ := d.Front(); v != -1 {
+		t.Fatalf("Expected -1 at the front, got %d", v)
+	}
+	if v, _ := d.Back(); v != 4 {
+		t.Fatalf("Expected 4 at the back, got %d", v)
+	}
+
+	d.Rotate(2)
+	if d.String() != "[1, 2, 3, 4, -1, 0]" {
+		t.Fatalf("Unexpected deque after Rotate(2): %s", d.String())
+	}
+	d.Rotate(-3)
+	if d.String() != "[4, -1, 0, 1, 2, 3]" {
+		t.Fatalf("Unexpected deque after Rotate(-3): %s", d.String())
+	}
+
+	if !d.Insert(1, 10) || !d.Set(0, 40) || d.Insert(8, 0) || d.Set(7, 0) {
+		t.Fatal("Unexpected positional result")
+	}
+	if v, ok := d.Remove(2); !ok || v != -1 {
+		t.Fatalf("Expected to remove -1, got %d", v)
+	}
+	if v, _ := d.At(1); v != 10 || d.String() != "[40, 10, 0, 1, 2, 3]" {
+		t.Fatalf("Unexpected deque: %s", d.String())
+	}
+
+	d.Clear()
+	if d.Len() != 0 || d.ToSlice() != nil {
+		t.Fatal("Expected an empty deque")
+	}
+}
+
+func TestDequeGrowShrink(t *testing.T) {
+	var d = deque.New[int](20)
+	if d.Cap() != 32 {
+		t.Fatalf("Expected capacity 32, got %d", d.Cap())
+	}
+	for i := 0; i < 1000; i++ {
+		d.PushFront(i)
+	}
+	if d.Cap() != 1024 {
+		t.Fatalf("Expected capacity 1024, got %d", d.Cap())
+	}
+	for i := 0; i < 990; i++ {
+		d.PopBack()
+	}
+	if d.Cap() > 64 {
+		t.Fatalf("Expected the deque to shrink, got capacity %d", d.Cap())
+	}
+	if v, _ := d.Front(); v != 999 {
+		t.Fatalf("Expected 999 at the front, got %d", v)
+	}
+}
+
+// Compare against a plain slice under random operations.
+func TestDequeRandom(t *testing.T) {
+	var (
+		r    = rand.New(rand.NewSource(1))
+		d    deque.Deque[int]
+		want []int
+	)
+	for op := 0; op < 20000; op++ {
+		switch r.Intn(8) {
+		case 0:
+			d.PushFront(op)
+			want = append([]int{op}, want...)
+		case 1:
+			d.PushBack(op)
+			want = append(want, op)
+		case 2:
+			var v, ok = d.PopFront()
+			if ok != (len(want) > 0) || ok && v != want[0] {
+				t.Fatalf("op %d: PopFront: got %d, %v", op, v, ok)
+			}
+			if ok {
+				want = want[1:]
+			}
+		case 3:
+			var v, ok = d.PopBack()
+			if ok != (len(want) > 0) || ok && v != want[len(want)-1] {
+				t.Fatalf("op %d: PopBack: got %d, %v", op, v, ok)
+			}
+			if ok {
+				want = want[:len(want)-1]
+			}
+		case 4:
+			var i = r.Intn(len(want) + 1)
+			d.Insert(i, op)
+			want = append(want[:i], append([]int{op}, want[i:]...)...)
+		case 5:
+			if len(want) == 0 {
+				continue
+			}
+			var i = r.Intn(len(want))
+			if v, _ := d.Remove(i); v != want[i] {
+				t.Fatalf("op %d: Remove(%d): expected %d, got %d", op, i, want[i], v)
+			}
+			want = append(want[:i], want[i+1:]...)
+		case 6:
+			if len(want) == 0 {
+				continue
+			}
+			var n = r.Intn(2*len(want)) - len(want)
+			d.Rotate(n)
+			var k = ((n % len(want)) + len(want)) % len(want)
+			want = append(append([]int{}, want[k:]...), want[:k]...)
+		case 7:
+			// Keep the deque from growing without bound.
+			for len(want) > 100 {
+				d.PopFront()
+				want = want[1:]
+			}
+		}
+
+		if d.Len() != len(want) {
+			t.Fatalf("op %d: expected length %d, got %d", op, len(want), d.Len())
+		}
+	}
+
+	var got = d.ToSlice()
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Index %d: expected %d, got %d", i, want[i], got[i])
+		}
+		if v, _ := d.At(i); v != want[i] {
+			t.Fatalf("At(%d): expected %d, got %d", i, want[i], v)
+		}
+	}
+}
+
+func BenchmarkDeque(b *testing.B) {
+	var d deque.Deque[int]
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+		d.PushFront(i)
+		if d.Len() >= 128 {
+			for d.Len() > 0 {
+				d.PopFront()
+			}
+		}
+	}
+}
+
+func BenchmarkDoubly(b *testing.B) {
+	var d linkedlist.Doubly[int]
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d.Append(i)
+		d.Prepend(i)
+		if d.Len() >= 128 {
+			for d.Len() > 0 {
+				d.Shift()
+			}
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package heap
+
+import (
+	"fmt"
+
+	"github.com/Nigel2392/go-datastructures"
+)
+
+// Whether a heap keeps its least or greatest value on top.
+type Order int
+
+const (
+	// The least value is on top.
+	Min Order = iota
+	// The greatest value is on top.
+	Max
+)
+
+func (o Order) String() string {
+	switch o {
+	case Min:
+		return "Min"
+	case Max:
+		return "Max"
+	}
+	return fmt.Sprintf("Order(%d)", int(o))
+}
+
+// A handle to a value in a heap.
+//
+// The handle stays valid while the value moves around the heap,
+// so it can be used to update or remove the value later.
+type Item[T any] struct {
+	value T
+	index int
+	heap  *Heap[T]
+}
+
+// Returns the value of the item.
+func (i *Item[T]) Value() T {
+	return i.value
+}
+
+// A binary heap, which can be used as a priority queue.
+//
+// The value on top is the one for which less returns true against every other value,
+// so a less function of a < b gives a min-heap.
+//
+// Push, Pop, Fix and Remove are O(log n), building a heap from a slice is O(n).
+//
+// A heap is not safe for concurrent use.
+type Heap[T any] struct {
+	items []*Item[T]
+	less  func(a, b T) bool
+}
+
+// Create a new heap ordered by the less function, with the given initial values.
+//
+// Use Init instead of passing values to get handles to the initial values.
+func NewFunc[T any](less func(a, b T) bool, values ...T) *Heap[T] {
+	var h = &Heap[T]{less: less}
+	h.Init(values...)
+	return h
+}
+
+// Create a new min- or max-heap of ordered values, with the given initial values.
+func NewOrdered[T datastructures.Ordered](order Order, values ...T) *Heap[T] {
+	if order == Max {
+		return NewFunc(func(a, b T) bool { return a > b }, values...)
+	}
+	return NewFunc(func(a, b T) bool { return a < b }, values...)
+}
+
+// Create a new min- or max-heap of comparable values, with the given initial values.
+func NewComparable[T datastructures.Comparable[T]](order Order, values ...T) *Heap[T] {
+	if order == Max {
+		return NewFunc(func(a, b T) bool { return b.Lt(a) }, values...)
+	}
+	return NewFunc(func(a, b T) bool { return a.Lt(b) }, values...)
+}
+
+// Replace the values in the heap with the given values, in O(n).
+//
+// Returns a handle to every value, in the order of the values.
+// Handles to the values which were in the heap before are no longer valid.
+func (h *Heap[T]) Init(values ...T) []*Item[T] {
+	h.Clear()
+	var items = make([]*Item[T], len(values))
+	for i, v := range values {
+		items[i] = &Item[T]{value: v, index: i, heap: h}
+	}
+	h.items = append(h.items, items...)
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return items
+}
+
+// Returns the number of values in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Push a value onto the heap.
+//
+// Returns a handle to the value.
+func (h *Heap[T]) Push(v T) *Item[T] {
+	var item = &Item[T]{value: v, index: len(h.items), heap: h}
+	h.items = append(h.items, item)
+	h.up(item.index)
+	return item
+}
+
+// Pop the value on top of the heap.
+//
+// It panics if the heap is empty.
+func (h *Heap[T]) Pop() T {
+	if len(h.items) == 0 {
+		panic("cannot Pop() from an empty heap")
+	}
+	return h.remove(0).value
+}
+
+// Pop the value on top of the heap.
+//
+// Returns false if the heap is empty.
+func (h *Heap[T]) PopOK() (v T, ok bool) {
+	if len(h.items) == 0 {
+		return
+	}
+	return h.remove(0).value, true
+}
+
+// Returns the value on top of the heap.
+//
+// Returns false if the heap is empty.
+func (h *Heap[T]) Peek() (v T, ok bool) {
+	if len(h.items) == 0 {
+		return
+	}
+	return h.items[0].value, true
+}
+
+// Push a value and then pop the value on top of the heap.
+//
+// This is faster than calling Push and Pop,
+// and returns v itself without touching the heap if it would end up on top.
+//
+// Returns the popped value, and a handle to v if it was pushed, or nil if it was popped straight away.
+func (h *Heap[T]) PushPop(v T) (T, *Item[T]) {
+	if len(h.items) == 0 || !h.less(h.items[0].value, v) {
+		return v, nil
+	}
+	return h.replaceTop(v)
+}
+
+// Pop the value on top of the heap and then push a value.
+//
+// This is faster than calling Pop and Push.
+// It panics if the heap is empty.
+//
+// Returns the popped value and a handle to v.
+func (h *Heap[T]) Replace(v T) (T, *Item[T]) {
+	if len(h.items) == 0 {
+		panic("cannot Replace() on an empty heap")
+	}
+	return h.replaceTop(v)
+}
+
+// Restore the heap order after the item's value was changed in place,
+// for example through a pointer when T is a pointer type.
+//
+// Update already does this, there is no need to call Fix after it.
+//
+// Returns false if the item is not in this heap.
+func (h *Heap[T]) Fix(item *Item[T]) bool {
+	if !h.owns(item) {
+		return false
+	}
+	if !h.down(item.index) {
+		h.up(item.index)
+	}
+	return true
+}
+
+// Change the value of an item and restore the heap order.
+//
+// Returns false if the item is not in this heap.
+func (h *Heap[T]) Update(item *Item[T], v T) bool {
+	if !h.owns(item) {
+		return false
+	}
+	item.value = v
+	return h.Fix(item)
+}
+
+// Remove an item from the heap.
+//
+// Returns false if the item is not in this heap.
+func (h *Heap[T]) Remove(item *Item[T]) bool {
+	if !h.owns(item) {
+		return false
+	}
+	h.remove(item.index)
+	return true
+}
+
+// Report whether the item is in this heap.
+func (h *Heap[T]) Contains(item *Item[T]) bool {
+	return h.owns(item)
+}
+
+// Returns the values in the heap, in heap order rather than sorted order.
+func (h *Heap[T]) ToSlice() []T {
+	if len(h.items) == 0 {
+		return nil
+	}
+	var values = make([]T, len(h.items))
+	for i, item := range h.items {
+		values[i] = item.value
+	}
+	return values
+}
+
+// Remove all values from the heap.
+func (h *Heap[T]) Clear() {
+	for i, item := range h.items {
+		item.heap, item.index = nil, -1
+		h.items[i] = nil
+	}
+	h.items = h.items[:0]
+}
+
+func (h *Heap[T]) owns(item *Item[T]) bool {
+	return item != nil && item.heap == h
+}
+
+// Puts a new item for v on top in place of the current top,
+// and returns the old top value and the new item.
+func (h *Heap[T]) replaceTop(v T) (T, *Item[T]) {
+	var top = h.items[0]
+	top.heap, top.index = nil, -1
+	var item = &Item[T]{value: v, index: 0, heap: h}
+	h.items[0] = item
+	h.down(0)
+	return top.value, item
+}
+
+// Removes the item at index i and returns it.
+func (h *Heap[T]) remove(i int) *Item[T] {
+	var last = len(h.items) - 1
+	var item = h.items[i]
+	if i != last {
+		h.swap(i, last)
+	}
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if i != last {
+		if !h.down(i) {
+			h.up(i)
+		}
+	}
+	item.heap, item.index = nil, -1
+	return item
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		var parent = (i - 1) / 2
+		if !h.less(h.items[i].value, h.items[parent].value) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// Moves the item at index i down, reports whether it moved.
+func (h *Heap[T]) down(i int) bool {
+	var start = i
+	for {
+		var child = 2*i + 1
+		if child >= len(h.items) {
+			break
+		}
+		if right := child + 1; right < len(h.items) && h.less(h.items[right].value, h.items[child].value) {
+			child = right
+		}
+		if !h.less(h.items[child].value, h.items[i].value) {
+			break
+		}
+		h.swap(i, child)
+		i = child
+	}
+	return i > start
+}
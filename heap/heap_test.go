@@ -0,0 +1,238 @@
+package heap_test
+
+import (
+	containerheap "container/heap"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/heap"
+)
+
+type cmpInt int
+
+func (a cmpInt) Lt(b cmpInt) bool { return a < b }
+
+func drain[T any](h *heap.Heap[T]) []T {
+	var values []T
+	for h.Len() > 0 {
+		values = append(values, h.Pop())
+	}
+	return values
+}
+
+func TestHeapOrdered(t *testing.T) {
+	var values = []int{5, 3, 8, 1, 9, 2, 7}
+
+	var minHeap = heap.NewOrdered(heap.Min, values...)
+	if v, _ := minHeap.Peek(); v != 1 {
+		t.Fatalf("Expected 1 on top, got %d", v)
+	}
+	if got := drain(minHeap); !sort.IntsAreSorted(got) || len(got) != len(values) {
+		t.Fatalf("Expected ascending values, got %v", got)
+	}
+
+	var maxHeap = heap.NewOrdered[int](heap.Max)
+	for _, v := range values {
+		maxHeap.Push(v)
+	}
+	if got := drain(maxHeap); got[0] != 9 || got[len(got)-1] != 1 || !sort.IsSorted(sort.Reverse(sort.IntSlice(got))) {
+		t.Fatalf("Expected descending values, got %v", got)
+	}
+
+	if _, ok := maxHeap.PopOK(); ok {
+		t.Fatal("Expected an empty heap")
+	}
+	if _, ok := maxHeap.Peek(); ok {
+		t.Fatal("Expected an empty heap")
+	}
+}
+
+func TestHeapComparable(t *testing.T) {
+	var h = heap.NewComparable(heap.Max, cmpInt(2), cmpInt(4), cmpInt(3))
+	if h.Pop() != 4 || h.Pop() != 3 || h.Pop() != 2 {
+		t.Fatal("Expected descending values")
+	}
+	h = heap.NewComparable(heap.Min, cmpInt(2), cmpInt(4), cmpInt(3))
+	if h.Pop() != 2 {
+		t.Fatal("Expected 2 on top")
+	}
+}
+
+func TestHeapPushPopReplace(t *testing.T) {
+	var h = heap.NewOrdered(heap.Min, 3, 5, 7)
+	// 1 would be on top, so it comes straight back.
+	if v, item := h.PushPop(1); v != 1 || item != nil || h.Len() != 3 {
+		t.Fatalf("Expected 1 without a handle, got %d", v)
+	}
+	var six, ten *heap.Item[int]
+	if v, item := h.PushPop(6); v != 3 || item == nil || item.Value() != 6 {
+		t.Fatalf("Expected 3 and a handle to 6, got %d", v)
+	} else {
+		six = item
+	}
+	if v, item := h.Replace(10); v != 5 || item == nil || item.Value() != 10 {
+		t.Fatalf("Expected 5 and a handle to 10, got %d", v)
+	} else {
+		ten = item
+	}
+	if !h.Update(ten, 1) || !h.Remove(six) {
+		t.Fatal("Expected the handles from PushPop and Replace to be in the heap")
+	}
+	if got := drain(h); len(got) != 2 || got[0] != 1 || got[1] != 7 {
+		t.Fatalf("Expected [1 7], got %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected Replace to panic on an empty heap")
+		}
+	}()
+	h.Replace(1)
+}
+
+func TestHeapInit(t *testing.T) {
+	var h = heap.NewOrdered[int](heap.Min)
+	var old = h.Push(100)
+	var items = h.Init(5, 2, 8, 1, 9)
+	if len(items) != 5 || h.Len() != 5 || h.Contains(old) {
+		t.Fatalf("Expected 5 new handles replacing the old values, got %d", len(items))
+	}
+	for i, v := range []int{5, 2, 8, 1, 9} {
+		if items[i].Value() != v || !h.Contains(items[i]) {
+			t.Fatalf("Expected handle %d to hold %d, got %d", i, v, items[i].Value())
+		}
+	}
+	if !h.Update(items[2], 0) || !h.Remove(items[3]) {
+		t.Fatal("Expected the handles from Init to be in the heap")
+	}
+	if got := drain(h); len(got) != 4 || got[0] != 0 || got[1] != 2 || got[2] != 5 || got[3] != 9 {
+		t.Fatalf("Expected [0 2 5 9], got %v", got)
+	}
+}
+
+func TestHeapHandles(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	var h = heap.NewFunc(func(a, b task) bool { return a.priority < b.priority })
+	var (
+		a = h.Push(task{"a", 5})
+		b = h.Push(task{"b", 3})
+		c = h.Push(task{"c", 8})
+		d = h.Push(task{"d", 1})
+	)
+
+	if !h.Update(c, task{"c", 0}) {
+		t.Fatal("Expected c to be updated")
+	}
+	if v, _ := h.Peek(); v.name != "c" {
+		t.Fatalf("Expected c on top, got %s", v.name)
+	}
+	if !h.Remove(d) || h.Remove(d) || h.Contains(d) {
+		t.Fatal("Expected d to be removed once")
+	}
+	if !h.Update(a, task{"a", 1}) {
+		t.Fatal("Expected a to be updated")
+	}
+
+	var names string
+	for _, v := range drain(h) {
+		names += v.name
+	}
+	if names != "cab" {
+		t.Fatalf("Expected cab, got %s", names)
+	}
+	if h.Fix(b) || h.Update(b, task{}) {
+		t.Fatal("Expected handles to be invalid after popping")
+	}
+
+	var other = heap.NewOrdered[int](heap.Min)
+	var item = other.Push(1)
+	if heap.NewOrdered[int](heap.Min).Remove(item) {
+		t.Fatal("Expected a handle to be rejected by another heap")
+	}
+	other.Clear()
+	if other.Contains(item) || other.Len() != 0 {
+		t.Fatal("Expected an empty heap")
+	}
+}
+
+// Compare against a sorted slice under random operations.
+func TestHeapRandom(t *testing.T) {
+	var (
+		r     = rand.New(rand.NewSource(1))
+		h     = heap.NewOrdered[int](heap.Min)
+		items []*heap.Item[int]
+	)
+	for i := 0; i < 5000; i++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			items = append(items, h.Push(r.Intn(1000)))
+		case 2:
+			if len(items) == 0 {
+				continue
+			}
+			var i = r.Intn(len(items))
+			h.Update(items[i], r.Intn(1000))
+		case 3:
+			if len(items) == 0 {
+				continue
+			}
+			var i = r.Intn(len(items))
+			h.Remove(items[i])
+			items = append(items[:i], items[i+1:]...)
+		}
+	}
+
+	var want = make([]int, len(items))
+	for i, item := range items {
+		want[i] = item.Value()
+	}
+	sort.Ints(want)
+	var got = drain(h)
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d values, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Index %d: expected %d, got %d", i, want[i], got[i])
+		}
+	}
+}
+
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *intHeap) Pop() any {
+	var old = *h
+	var x = old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+func BenchmarkHeap(b *testing.B) {
+	var h = heap.NewOrdered[int](heap.Min)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		h.Push(i ^ 0x5555)
+		if h.Len() > 1024 {
+			h.Pop()
+		}
+	}
+}
+
+func BenchmarkContainerHeap(b *testing.B) {
+	var h = new(intHeap)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		containerheap.Push(h, i^0x5555)
+		if h.Len() > 1024 {
+			containerheap.Pop(h)
+		}
+	}
+}
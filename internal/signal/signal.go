@@ -0,0 +1,66 @@
+// Package signal provides a wake-up signal for goroutines waiting on a condition.
+package signal
+
+// A wake-up signal for goroutines waiting on a condition.
+//
+// Every call to Wait registers a waiter and returns its own channel,
+// which receives a value when the waiter is woken up,
+// so waiters can select on it alongside a context or timer.
+// Notify wakes up a single waiter, in the order they started waiting,
+// and Broadcast wakes up all of them.
+// Unlike sync.Cond, waiting can be abandoned when a context is done, by calling Cancel.
+//
+// All methods must be called with the lock guarding the condition held.
+// The zero value is ready to use.
+type Signal struct {
+	waiters []chan struct{}
+}
+
+// Registers a waiter, and returns a channel which receives a value when it is woken up.
+//
+// A waiter which stops waiting before it is woken up must call Cancel.
+func (s *Signal) Wait() <-chan struct{} {
+	var ch = make(chan struct{}, 1)
+	s.waiters = append(s.waiters, ch)
+	return ch
+}
+
+// Stop waiting on a channel returned by Wait.
+//
+// If the waiter was already woken up, the wake-up is passed on to the next waiter,
+// so it is not lost.
+func (s *Signal) Cancel(ch <-chan struct{}) {
+	for i, w := range s.waiters {
+		if w == ch {
+			copy(s.waiters[i:], s.waiters[i+1:])
+			s.waiters[len(s.waiters)-1] = nil
+			s.waiters = s.waiters[:len(s.waiters)-1]
+			return
+		}
+	}
+	select {
+	case <-ch:
+		s.Notify()
+	default:
+	}
+}
+
+// Wake up the longest waiting waiter, if there is one.
+func (s *Signal) Notify() {
+	if len(s.waiters) == 0 {
+		return
+	}
+	var ch = s.waiters[0]
+	s.waiters[0] = nil
+	s.waiters = s.waiters[1:]
+	ch <- struct{}{}
+}
+
+// Wake up every waiter.
+func (s *Signal) Broadcast() {
+	for i, ch := range s.waiters {
+		ch <- struct{}{}
+		s.waiters[i] = nil
+	}
+	s.waiters = s.waiters[:0]
+}
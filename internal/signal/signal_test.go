@@ -0,0 +1,55 @@
+package signal_test
+
+import (
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/internal/signal"
+)
+
+func woken(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestSignalNotify(t *testing.T) {
+	var s signal.Signal
+	s.Notify()
+
+	var a, b, c = s.Wait(), s.Wait(), s.Wait()
+	s.Notify()
+	if !woken(a) || woken(b) || woken(c) {
+		t.Fatal("Expected Notify to wake only the first waiter")
+	}
+	s.Broadcast()
+	if !woken(b) || !woken(c) {
+		t.Fatal("Expected Broadcast to wake the other waiters")
+	}
+	s.Notify()
+	if woken(a) || woken(b) || woken(c) {
+		t.Fatal("Expected no waiters to be left")
+	}
+}
+
+func TestSignalCancel(t *testing.T) {
+	var s signal.Signal
+	var a, b, c = s.Wait(), s.Wait(), s.Wait()
+
+	// A waiter which gives up before it is woken is skipped.
+	s.Cancel(a)
+	s.Notify()
+	if woken(a) || !woken(b) {
+		t.Fatal("Expected Notify to skip the cancelled waiter")
+	}
+
+	// A waiter which gives up after it is woken passes the wake-up on.
+	var d = s.Wait()
+	s.Notify()
+	s.Cancel(c)
+	if woken(c) || !woken(d) {
+		t.Fatal("Expected the wake-up to be passed on")
+	}
+}
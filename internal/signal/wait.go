@@ -0,0 +1,86 @@
+package signal
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Wait calls try with mu held until it succeeds, waiting on s in between,
+// and returns what try returned.
+//
+// Returns false if done is closed before try succeeds.
+// A nil done waits forever.
+func Wait[T any](mu sync.Locker, s *Signal, done <-chan struct{}, try func() (T, bool)) (value T, ok bool) {
+	for {
+		mu.Lock()
+		if value, ok = try(); ok {
+			mu.Unlock()
+			return value, true
+		}
+		var ch = s.Wait()
+		mu.Unlock()
+
+		select {
+		case <-ch:
+			// A goroutine which is not waiting may have got there first, so try again.
+		case <-done:
+			mu.Lock()
+			s.Cancel(ch)
+			mu.Unlock()
+			return value, false
+		}
+	}
+}
+
+// WaitContext is like Wait, but stops waiting when the context is done.
+//
+// It returns the context's error if the context is done before try succeeds.
+func WaitContext[T any](ctx context.Context, mu sync.Locker, s *Signal, try func() (T, bool)) (value T, err error) {
+	var v, ok = Wait(mu, s, ctx.Done(), try)
+	if !ok {
+		return value, ctx.Err()
+	}
+	return v, nil
+}
+
+// WaitTimeout is like Wait, but stops waiting after d.
+func WaitTimeout[T any](d time.Duration, mu sync.Locker, s *Signal, try func() (T, bool)) (value T, ok bool) {
+	var ctx, cancel = context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return Wait(mu, s, ctx.Done(), try)
+}
+
+// Waiter waits for try to succeed in a new goroutine,
+// and sends the value on the returned channel, which is then closed.
+//
+// The goroutine only exits once try succeeds.
+func Waiter[T any](mu sync.Locker, s *Signal, try func() (T, bool)) <-chan T {
+	var c = make(chan T, 1)
+	go func() {
+		var v, _ = Wait(mu, s, nil, try)
+		c <- v
+		close(c)
+	}()
+	return c
+}
+
+// WaitDeadline waits up to d for try to succeed in a new goroutine.
+//
+// The value is sent on ret, or the current time on expired if d passes first,
+// and ret is closed either way.
+func WaitDeadline[T any](d time.Duration, mu sync.Locker, s *Signal, try func() (T, bool)) (ret <-chan T, expired <-chan time.Time) {
+	var (
+		c       = make(chan T, 1)
+		timeout = make(chan time.Time, 1)
+	)
+	go func() {
+		if v, ok := WaitTimeout(d, mu, s, try); ok {
+			c <- v
+		} else {
+			timeout <- time.Now()
+		}
+		close(c)
+	}()
+	return c, timeout
+}
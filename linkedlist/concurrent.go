@@ -0,0 +1,160 @@
+package linkedlist
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+type concurrentNode[T any] struct {
+	value T
+	next  atomic.Pointer[concurrentNode[T]]
+}
+
+// A lock-free, unbounded multi-producer multi-consumer FIFO queue.
+//
+// It is the Michael-Scott queue: a singly linked list with a sentinel head,
+// where producers and consumers only synchronise through compare-and-swap on the
+// head, the tail and the next pointer of the tail.
+// Go's garbage collector keeps removed nodes alive while they are still referenced,
+// so the queue does not suffer from the ABA problem.
+//
+// The zero value is an empty queue, ready to use.
+// A queue must not be copied after first use.
+type ConcurrentQueue[T any] struct {
+	head atomic.Pointer[concurrentNode[T]]
+	tail atomic.Pointer[concurrentNode[T]]
+	len  atomic.Int64
+
+	// Consumers blocked in DequeueContext,
+	// and the channel producers use to wake one of them.
+	waiters atomic.Int64
+	signal  chan struct{}
+
+	once sync.Once
+}
+
+// Create a new concurrent queue.
+func NewConcurrentQueue[T any]() *ConcurrentQueue[T] {
+	var q = new(ConcurrentQueue[T])
+	q.init()
+	return q
+}
+
+func (q *ConcurrentQueue[T]) init() {
+	q.once.Do(func() {
+		var sentinel = new(concurrentNode[T])
+		q.head.Store(sentinel)
+		q.tail.Store(sentinel)
+		q.signal = make(chan struct{}, 1)
+	})
+}
+
+// Returns the length of the queue.
+//
+// The length is approximate while values are being enqueued or dequeued concurrently.
+func (q *ConcurrentQueue[T]) Len() int {
+	var n = q.len.Load()
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
+
+// Add a value to the back of the queue.
+//
+// Enqueue never blocks.
+func (q *ConcurrentQueue[T]) Enqueue(v T) {
+	q.init()
+	var n = &concurrentNode[T]{value: v}
+	// Count the value before it becomes visible,
+	// so Len overestimates rather than going negative.
+	q.len.Add(1)
+	for {
+		var tail = q.tail.Load()
+		var next = tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// The tail is lagging behind, help move it forward.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			break
+		}
+	}
+
+	if q.waiters.Load() > 0 {
+		q.wake()
+	}
+}
+
+// Remove a value from the front of the queue.
+//
+// Returns false if the queue is empty.
+func (q *ConcurrentQueue[T]) Dequeue() (v T, ok bool) {
+	q.init()
+	for {
+		var head = q.head.Load()
+		var tail = q.tail.Load()
+		var next = head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			return v, false
+		}
+		if head == tail {
+			// The tail is lagging behind, help move it forward.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// The value must be read before the swap,
+		// after it the node may already be dequeued by another consumer.
+		v = next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.len.Add(-1)
+			return v, true
+		}
+	}
+}
+
+// Remove a value from the front of the queue,
+// waiting for one to be enqueued if the queue is empty.
+//
+// Returns the context's error if it is done before a value is available.
+func (q *ConcurrentQueue[T]) DequeueContext(ctx context.Context) (v T, err error) {
+	q.init()
+	if v, ok := q.Dequeue(); ok {
+		return v, nil
+	}
+
+	q.waiters.Add(1)
+	defer q.waiters.Add(-1)
+	for {
+		// Check again after registering as a waiter,
+		// a value enqueued before that would not have signalled.
+		if v, ok := q.Dequeue(); ok {
+			// Pass the wake-up on if there are more values than this waiter took.
+			if q.Len() > 0 && q.waiters.Load() > 1 {
+				q.wake()
+			}
+			return v, nil
+		}
+		select {
+		case <-q.signal:
+		case <-ctx.Done():
+			return v, ctx.Err()
+		}
+	}
+}
+
+func (q *ConcurrentQueue[T]) wake() {
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
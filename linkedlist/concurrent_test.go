@@ -0,0 +1,206 @@
+package linkedlist_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+func TestConcurrentQueue(t *testing.T) {
+	var q linkedlist.ConcurrentQueue[int]
+	if _, ok := q.Dequeue(); ok || q.Len() != 0 {
+		t.Fatal("Expected an empty queue")
+	}
+	for i := 0; i < 10; i++ {
+		q.Enqueue(i)
+	}
+	if q.Len() != 10 {
+		t.Fatalf("Expected length 10, got %d", q.Len())
+	}
+	for i := 0; i < 10; i++ {
+		if v, ok := q.Dequeue(); !ok || v != i {
+			t.Fatalf("Expected %d, got %d", i, v)
+		}
+	}
+	if _, ok := q.Dequeue(); ok || q.Len() != 0 {
+		t.Fatal("Expected an empty queue")
+	}
+}
+
+// Every value must be dequeued exactly once,
+// and the values of a single producer in the order they were enqueued.
+func TestConcurrentQueueStress(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 5000
+
+	var (
+		q        = linkedlist.NewConcurrentQueue[[2]int]()
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		received = make([][]int, producers)
+		done     = make(chan struct{})
+	)
+
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue([2]int{p, i})
+			}
+		}(p)
+	}
+
+	var cwg sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		cwg.Add(1)
+		go func() {
+			defer cwg.Done()
+			var local = make([][]int, producers)
+			defer func() {
+				mu.Lock()
+				for p := range local {
+					received[p] = append(received[p], local[p]...)
+				}
+				mu.Unlock()
+			}()
+			for {
+				var v, ok = q.Dequeue()
+				if ok {
+					var prev = local[v[0]]
+					if len(prev) > 0 && prev[len(prev)-1] >= v[1] {
+						t.Errorf("Producer %d: %d dequeued after %d", v[0], v[1], prev[len(prev)-1])
+					}
+					local[v[0]] = append(prev, v[1])
+					continue
+				}
+				select {
+				case <-done:
+					if q.Len() == 0 {
+						return
+					}
+				default:
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(done)
+	cwg.Wait()
+
+	for p, values := range received {
+		if len(values) != perProducer {
+			t.Fatalf("Producer %d: expected %d values, got %d", p, perProducer, len(values))
+		}
+		var seen = make(map[int]bool, perProducer)
+		for _, v := range values {
+			if seen[v] {
+				t.Fatalf("Producer %d: %d dequeued twice", p, v)
+			}
+			seen[v] = true
+		}
+	}
+}
+
+func TestConcurrentQueueDequeueContext(t *testing.T) {
+	var q = linkedlist.NewConcurrentQueue[int]()
+
+	var ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.DequeueContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	const consumers, values = 8, 1000
+	var (
+		wg  sync.WaitGroup
+		sum = make(chan int, consumers)
+	)
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var total int
+			for {
+				var v, err = q.DequeueContext(context.Background())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if v < 0 {
+					sum <- total
+					return
+				}
+				total += v
+			}
+		}()
+	}
+
+	time.Sleep(time.Millisecond)
+	for i := 1; i <= values; i++ {
+		q.Enqueue(i)
+	}
+	for c := 0; c < consumers; c++ {
+		q.Enqueue(-1)
+	}
+	wg.Wait()
+	close(sum)
+
+	var total int
+	for s := range sum {
+		total += s
+	}
+	if total != values*(values+1)/2 {
+		t.Fatalf("Expected a total of %d, got %d", values*(values+1)/2, total)
+	}
+}
+
+type mutexQueue struct {
+	mu   sync.Mutex
+	list linkedlist.Doubly[int]
+}
+
+func (q *mutexQueue) Enqueue(v int) {
+	q.mu.Lock()
+	q.list.Append(v)
+	q.mu.Unlock()
+}
+
+func (q *mutexQueue) Dequeue() (v int, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.list.Len() == 0 {
+		return
+	}
+	return q.list.Shift(), true
+}
+
+func benchmarkQueue(b *testing.B, q interface {
+	Enqueue(int)
+	Dequeue() (int, bool)
+}) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			if i%2 == 0 {
+				q.Enqueue(i)
+			} else {
+				q.Dequeue()
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+	benchmarkQueue(b, linkedlist.NewConcurrentQueue[int]())
+}
+
+func BenchmarkMutexDoublyQueue(b *testing.B) {
+	benchmarkQueue(b, new(mutexQueue))
+}
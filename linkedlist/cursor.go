@@ -0,0 +1,266 @@
+package linkedlist
+
+// A bidirectional cursor over a Doubly linked list.
+//
+// A cursor is either positioned on a node, or in the gap between two nodes
+// (before the head, after the tail, or where a node was removed).
+//
+// Removing and inserting through the cursor is safe while iterating.
+type Cursor[T any] struct {
+	list *Doubly[T]
+	node *DoublyNode[T]
+
+	// The neighbours of the gap the cursor is in when node is nil,
+	// or of node when the cursor moved onto it, so the cursor can still find its place
+	// if node is removed from the list without going through the cursor.
+	prev *DoublyNode[T]
+	next *DoublyNode[T]
+	// Whether an empty gap is at the end of the list rather than the beginning.
+	back bool
+}
+
+// Returns a cursor positioned before the head of the list.
+//
+// Call Next to move it to the first node.
+func (l *Doubly[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{list: l}
+}
+
+// Returns a cursor positioned after the tail of the list.
+//
+// Call Prev to move it to the last node.
+func (l *Doubly[T]) CursorBack() *Cursor[T] {
+	return &Cursor[T]{list: l, back: true}
+}
+
+// Move the cursor to the next node.
+//
+// Returns false if there is no next node, the cursor is then positioned after the tail.
+func (c *Cursor[T]) Next() bool {
+	c.leaveRemoved()
+	var before, after *DoublyNode[T]
+	if c.node != nil {
+		before, after = c.node, c.node.next
+	} else {
+		before, after = c.gap()
+	}
+	if after == nil {
+		c.node = nil
+		c.prev, c.next, c.back = before, nil, true
+		return false
+	}
+	c.moveTo(after)
+	return true
+}
+
+// Move the cursor to the previous node.
+//
+// Returns false if there is no previous node, the cursor is then positioned before the head.
+func (c *Cursor[T]) Prev() bool {
+	c.leaveRemoved()
+	var before, after *DoublyNode[T]
+	if c.node != nil {
+		before, after = c.node.prev, c.node
+	} else {
+		before, after = c.gap()
+	}
+	if before == nil {
+		c.node = nil
+		c.prev, c.next, c.back = nil, after, false
+		return false
+	}
+	c.moveTo(before)
+	return true
+}
+
+// Returns the node the cursor is positioned on, or nil if it is in a gap.
+func (c *Cursor[T]) Node() *DoublyNode[T] {
+	return c.node
+}
+
+// Returns the value the cursor is positioned on.
+//
+// Returns the zero value of the type if the cursor is in a gap.
+func (c *Cursor[T]) Value() (v T) {
+	if c.node == nil {
+		return
+	}
+	return c.node.value
+}
+
+// Set the value the cursor is positioned on.
+//
+// Returns false if the cursor is in a gap.
+func (c *Cursor[T]) Set(v T) bool {
+	if c.node == nil {
+		return false
+	}
+	c.node.value = v
+	return true
+}
+
+// Remove the node the cursor is positioned on.
+//
+// The cursor is left in the gap where the node was,
+// so Next and Prev move to the neighbours of the removed node.
+//
+// Returns false if the cursor is in a gap.
+func (c *Cursor[T]) Remove() bool {
+	if !c.list.owns(c.node) {
+		return false
+	}
+	c.prev, c.next, c.back = c.node.prev, c.node.next, false
+	c.list.removeNode(c.node)
+	c.node = nil
+	return true
+}
+
+// Insert a value before the cursor.
+//
+// Calling Prev afterwards moves the cursor to the new node.
+//
+// Returns the new node.
+func (c *Cursor[T]) InsertBefore(v T) *DoublyNode[T] {
+	c.leaveRemoved()
+	if c.node != nil {
+		var n = c.list.InsertBefore(c.node, v)
+		c.prev = n
+		return n
+	}
+	var before, after = c.gap()
+	var n *DoublyNode[T]
+	switch {
+	case after != nil:
+		n = c.list.InsertBefore(after, v)
+	case before != nil:
+		n = c.list.InsertAfter(before, v)
+	default:
+		n = c.list.PushBack(v)
+	}
+	c.prev, c.next = n, after
+	return n
+}
+
+// Insert a value after the cursor.
+//
+// Calling Next afterwards moves the cursor to the new node.
+//
+// Returns the new node.
+func (c *Cursor[T]) InsertAfter(v T) *DoublyNode[T] {
+	c.leaveRemoved()
+	if c.node != nil {
+		var n = c.list.InsertAfter(c.node, v)
+		c.next = n
+		return n
+	}
+	var before, after = c.gap()
+	var n *DoublyNode[T]
+	switch {
+	case before != nil:
+		n = c.list.InsertAfter(before, v)
+	case after != nil:
+		n = c.list.InsertBefore(after, v)
+	default:
+		n = c.list.PushFront(v)
+	}
+	c.prev, c.next = before, n
+	return n
+}
+
+// Position the cursor on a node, remembering its neighbours.
+func (c *Cursor[T]) moveTo(n *DoublyNode[T]) {
+	c.node = n
+	c.prev, c.next, c.back = n.prev, n.next, false
+}
+
+// Move the cursor into the gap where its node was,
+// if the node was removed from the list without going through the cursor.
+func (c *Cursor[T]) leaveRemoved() {
+	if c.node != nil && !c.list.owns(c.node) {
+		c.node = nil
+	}
+}
+
+// Returns the nodes on either side of the gap the cursor is in.
+//
+// Neighbours which have since been removed from the list are skipped.
+func (c *Cursor[T]) gap() (before, after *DoublyNode[T]) {
+	switch {
+	case c.list.owns(c.prev):
+		return c.prev, c.prev.next
+	case c.list.owns(c.next):
+		return c.next.prev, c.next
+	case c.back:
+		return c.list.tail, nil
+	default:
+		return nil, c.list.head
+	}
+}
+
+// Returns a sequence of the indices and values in the list, from head to tail.
+//
+// The sequence can be used with range-over-func:
+//
+//	for i, v := range l.All() { ... }
+func (l *Doubly[T]) All() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		for n := l.head; n != nil; {
+			var next = n.next
+			if !yield(i, n.value) {
+				return
+			}
+			n = next
+			i++
+		}
+	}
+}
+
+// Returns a sequence of the indices and values in the list, from tail to head.
+func (l *Doubly[T]) Backward() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		var i = l.len - 1
+		for n := l.tail; n != nil; {
+			var prev = n.prev
+			if !yield(i, n.value) {
+				return
+			}
+			n = prev
+			i--
+		}
+	}
+}
+
+// Returns a sequence of the indices and values in the list, from head to tail.
+//
+// The sequence can be used with range-over-func:
+//
+//	for i, v := range l.All() { ... }
+func (l *Singly[T]) All() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		for n := l.head; n != nil; {
+			var next = n.next
+			if !yield(i, n.value) {
+				return
+			}
+			n = next
+			i++
+		}
+	}
+}
+
+// Returns a sequence of the indices and values in the list, from tail to head.
+//
+// A singly linked list cannot be walked backwards,
+// so the values are copied into a slice first, which is O(n) memory.
+func (l *Singly[T]) Backward() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		var values = l.ToSlice()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(i, values[i]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+func TestCursorIterate(t *testing.T) {
+	var d = new(linkedlist.Doubly[int])
+	for i := 0; i < 5; i++ {
+		d.Append(i)
+	}
+
+	var c = d.Cursor()
+	if c.Prev() {
+		t.Fatal("Expected Prev before the head to fail")
+	}
+	var got []int
+	for c.Next() {
+		got = append(got, c.Value())
+	}
+	if len(got) != 5 || got[4] != 4 {
+		t.Fatalf("Expected [0 1 2 3 4], got %v", got)
+	}
+	if c.Node() != nil || c.Set(1) || c.Remove() {
+		t.Fatal("Expected the cursor to be past the tail")
+	}
+
+	got = got[:0]
+	for c.Prev() {
+		got = append(got, c.Value())
+	}
+	if len(got) != 5 || got[0] != 4 || got[4] != 0 {
+		t.Fatalf("Expected [4 3 2 1 0], got %v", got)
+	}
+
+	var back = d.CursorBack()
+	if !back.Prev() || back.Value() != 4 {
+		t.Fatalf("Expected CursorBack to start at the tail, got %d", back.Value())
+	}
+
+	var empty = new(linkedlist.Doubly[int]).Cursor()
+	if empty.Next() || empty.Prev() {
+		t.Fatal("Expected an empty cursor")
+	}
+}
+
+func TestCursorRemove(t *testing.T) {
+	var d = new(linkedlist.Doubly[int])
+	for i := 0; i < 10; i++ {
+		d.Append(i)
+	}
+
+	// Remove the even values while iterating.
+	for c := d.Cursor(); c.Next(); {
+		if c.Value()%2 == 0 {
+			if !c.Remove() {
+				t.Fatal("Expected Remove to succeed")
+			}
+		}
+	}
+	checkDoubly(t, d)
+	if d.String() != "[1, 3, 5, 7, 9]" {
+		t.Fatalf("Unexpected list: %s", d)
+	}
+
+	// Removing, then stepping back, lands on the previous node.
+	var c = d.Cursor()
+	c.Next()
+	c.Next()
+	c.Remove()
+	if !c.Prev() || c.Value() != 1 {
+		t.Fatalf("Expected to step back to 1, got %d", c.Value())
+	}
+	if !c.Next() || c.Value() != 5 {
+		t.Fatalf("Expected to step forward to 5, got %d", c.Value())
+	}
+
+	// Removing the tail leaves the cursor at the end.
+	var back = d.CursorBack()
+	back.Prev()
+	back.Remove()
+	if back.Next() {
+		t.Fatal("Expected no next node after removing the tail")
+	}
+	if !back.Prev() || back.Value() != 7 {
+		t.Fatalf("Expected to step back to 7, got %d", back.Value())
+	}
+	checkDoubly(t, d)
+
+	// Remove everything.
+	for c := d.Cursor(); c.Next(); {
+		c.Remove()
+	}
+	if d.Len() != 0 || d.Head() != nil || d.Tail() != nil {
+		t.Fatalf("Expected an empty list, got %s", d)
+	}
+}
+
+func TestCursorRemovedFromList(t *testing.T) {
+	var d = new(linkedlist.Doubly[int])
+	for i := 0; i < 5; i++ {
+		d.Append(i)
+	}
+
+	// The node under the cursor is removed through the list, not the cursor.
+	var c = d.Cursor()
+	c.Next()
+	c.Next()
+	if err := d.RemoveNode(c.Node()); err != nil {
+		t.Fatal(err)
+	}
+	var got []int
+	for c.Next() {
+		got = append(got, c.Value())
+	}
+	if len(got) != 3 || got[0] != 2 || got[2] != 4 {
+		t.Fatalf("Expected [2 3 4], got %v", got)
+	}
+
+	c = d.CursorBack()
+	c.Prev()
+	c.Prev()
+	if !d.Remove(func(v int) bool { return v == 3 }) {
+		t.Fatal("Expected 3 to be removed")
+	}
+	if !c.Prev() || c.Value() != 2 {
+		t.Fatalf("Expected to step back to 2, got %d", c.Value())
+	}
+
+	// The previous neighbour goes as well.
+	c = d.Cursor()
+	c.Next()
+	c.Next()
+	if d.RemoveIf(func(v int) bool { return v <= 2 }) != 2 {
+		t.Fatal("Expected 2 values to be removed")
+	}
+	if !c.Next() || c.Value() != 4 {
+		t.Fatalf("Expected to move to 4, got %d", c.Value())
+	}
+	checkDoubly(t, d)
+}
+
+func TestCursorInsertSet(t *testing.T) {
+	var d = new(linkedlist.Doubly[string])
+	var c = d.Cursor()
+	c.InsertAfter("b")
+	if !c.Next() || c.Value() != "b" {
+		t.Fatalf("Expected to move to the inserted value, got %q", c.Value())
+	}
+	c.InsertBefore("a")
+	c.InsertAfter("d")
+	c.Next()
+	c.InsertBefore("c")
+	if d.String() != "[a, b, c, d]" {
+		t.Fatalf("Unexpected list: %s", d)
+	}
+
+	c.Set("D")
+	c.Prev()
+	c.Remove()
+	// Inserting into the gap left by Remove.
+	c.InsertBefore("c1")
+	c.InsertAfter("c2")
+	checkDoubly(t, d)
+	if d.String() != "[a, b, c1, c2, D]" {
+		t.Fatalf("Unexpected list: %s", d)
+	}
+	if !c.Prev() || c.Value() != "c1" {
+		t.Fatalf("Expected to step back to c1, got %q", c.Value())
+	}
+
+	var end = d.Cursor()
+	for end.Next() {
+	}
+	end.InsertBefore("e")
+	end.InsertAfter("f")
+	if d.String() != "[a, b, c1, c2, D, e, f]" {
+		t.Fatalf("Unexpected list: %s", d)
+	}
+	checkDoubly(t, d)
+}
+
+func TestSequences(t *testing.T) {
+	var d = new(linkedlist.Doubly[int])
+	var s = new(linkedlist.Singly[int])
+	for i := 0; i < 5; i++ {
+		d.Append(i * 10)
+		s.Append(i * 10)
+	}
+
+	for _, seq := range []func(func(int, int) bool){d.All(), s.All()} {
+		var want = 0
+		seq(func(i, v int) bool {
+			if i != want || v != i*10 {
+				t.Fatalf("Expected %d: %d, got %d: %d", want, want*10, i, v)
+			}
+			want++
+			return true
+		})
+		if want != 5 {
+			t.Fatalf("Expected 5 values, got %d", want)
+		}
+	}
+
+	for _, seq := range []func(func(int, int) bool){d.Backward(), s.Backward()} {
+		var want = 4
+		seq(func(i, v int) bool {
+			if i != want || v != i*10 {
+				t.Fatalf("Expected %d: %d, got %d: %d", want, want*10, i, v)
+			}
+			want--
+			return want >= 2
+		})
+		if want != 1 {
+			t.Fatalf("Expected the sequence to stop early, got %d", want)
+		}
+	}
+}
@@ -19,6 +19,10 @@ type Doubly[T any] struct {
 	head *DoublyNode[T]
 	tail *DoublyNode[T]
 	len  int
+	// recycles removed nodes, nil unless created with NewDoublyPooled.
+	pool *nodePool[*DoublyNode[T]]
+	// shared by the nodes of the list, created on first use.
+	owner *doublyOwner[T]
 }
 
 // Returns the length of the list.
@@ -55,12 +59,140 @@ func (l *Doubly[T]) Tail() *DoublyNode[T] {
 
 // Append a value to the end of the list.
 func (l *Doubly[T]) Append(v T) {
-	l.append(&DoublyNode[T]{value: v})
+	l.PushBack(v)
 }
 
 // Prepend a value to the beginning of the list.
 func (l *Doubly[T]) Prepend(v T) {
-	l.prepend(&DoublyNode[T]{value: v})
+	l.PushFront(v)
+}
+
+// Append a value to the end of the list.
+//
+// Returns the node holding the value, which can be used as a handle
+// for InsertBefore, InsertAfter and the Move methods.
+func (l *Doubly[T]) PushBack(v T) *DoublyNode[T] {
+	var n = l.newNode(v)
+	l.append(n)
+	return n
+}
+
+// Prepend a value to the beginning of the list.
+//
+// Returns the node holding the value, which can be used as a handle
+// for InsertBefore, InsertAfter and the Move methods.
+func (l *Doubly[T]) PushFront(v T) *DoublyNode[T] {
+	var n = l.newNode(v)
+	l.prepend(n)
+	return n
+}
+
+// Returns the value at a given index.
+//
+// Returns false if the index is out of range.
+func (l *Doubly[T]) Get(i int) (v T, ok bool) {
+	var n = l.nodeAt(i)
+	if n == nil {
+		return
+	}
+	return n.value, true
+}
+
+// Set the value at a given index.
+//
+// Returns false if the index is out of range.
+func (l *Doubly[T]) Set(i int, v T) bool {
+	var n = l.nodeAt(i)
+	if n == nil {
+		return false
+	}
+	n.value = v
+	return true
+}
+
+// Insert a value at a given index.
+//
+// Inserting at index Len() appends the value.
+//
+// Returns false if the index is out of range.
+func (l *Doubly[T]) InsertAt(i int, v T) bool {
+	if i < 0 || i > l.len {
+		return false
+	}
+	if i == l.len {
+		l.PushBack(v)
+		return true
+	}
+	l.InsertBefore(l.nodeAt(i), v)
+	return true
+}
+
+// Insert a value before the given node.
+//
+// Returns the new node, or nil if the mark does not belong to this list.
+func (l *Doubly[T]) InsertBefore(mark *DoublyNode[T], v T) *DoublyNode[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	var n = l.newNode(v)
+	l.insertBefore(n, mark)
+	return n
+}
+
+// Insert a value after the given node.
+//
+// Returns the new node, or nil if the mark does not belong to this list.
+func (l *Doubly[T]) InsertAfter(mark *DoublyNode[T], v T) *DoublyNode[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	var n = l.newNode(v)
+	l.insertAfter(n, mark)
+	return n
+}
+
+// Move a node to the beginning of the list.
+//
+// The list is not modified if the node does not belong to it.
+func (l *Doubly[T]) MoveToFront(n *DoublyNode[T]) {
+	if !l.owns(n) || l.head == n {
+		return
+	}
+	l.unlink(n)
+	l.prepend(n)
+}
+
+// Move a node to the end of the list.
+//
+// The list is not modified if the node does not belong to it.
+func (l *Doubly[T]) MoveToBack(n *DoublyNode[T]) {
+	if !l.owns(n) || l.tail == n {
+		return
+	}
+	l.unlink(n)
+	l.append(n)
+}
+
+// Move a node to directly before the mark node.
+//
+// The list is not modified if either node does not belong to it.
+func (l *Doubly[T]) MoveBefore(n, mark *DoublyNode[T]) {
+	if !l.owns(n) || !l.owns(mark) || n == mark || mark.prev == n {
+		return
+	}
+	l.unlink(n)
+	l.insertBefore(n, mark)
+}
+
+// Move a node to directly after the mark node.
+//
+// The list is not modified if either node does not belong to it.
+func (l *Doubly[T]) MoveAfter(n, mark *DoublyNode[T]) {
+	if !l.owns(n) || !l.owns(mark) || n == mark || mark.next == n {
+		return
+	}
+	l.unlink(n)
+	l.insertAfter(n, mark)
 }
 
 // Pop a value from the end of the list.
@@ -73,7 +205,7 @@ func (l *Doubly[T]) Pop() T {
 		panic("cannot Pop() from an empty list")
 	}
 	var v = l.tail.value
-	l.RemoveIndex(l.len - 1)
+	l.removeNode(l.tail)
 	return v
 }
 
@@ -87,12 +219,34 @@ func (l *Doubly[T]) Shift() T {
 		panic("cannot Shift() from an empty list")
 	}
 	var v = l.head.value
-	l.RemoveIndex(0)
+	l.removeNode(l.head)
 	return v
 }
 
 // Reset the list.
+//
+// Detaches all nodes, so handles held by the caller can no longer be used with this list.
+//
+// This is O(1), unless the list is pooled and its nodes have to be recycled one by one.
 func (l *Doubly[T]) Reset() {
+	if l.pool == nil {
+		// Detach all nodes at once, the list starts afresh with a new owner.
+		if l.owner != nil {
+			l.owner.list = nil
+			l.owner = nil
+		}
+	} else {
+		var zero T
+		for n := l.head; n != nil; {
+			var next = n.next
+			n.prev = nil
+			n.next = nil
+			n.owner = nil
+			n.value = zero
+			l.pool.put(n)
+			n = next
+		}
+	}
 	l.head = nil
 	l.tail = nil
 	l.len = 0
@@ -100,37 +254,116 @@ func (l *Doubly[T]) Reset() {
 
 // Remove a value from the list at a given index.
 func (l *Doubly[T]) RemoveIndex(i int) bool {
-	if i < 0 || i >= l.len {
+	var n = l.nodeAt(i)
+	if n == nil {
 		return false
 	}
+	l.removeNode(n)
+	return true
+}
 
-	if i == 0 {
-		l.remove(i, l.head)
-		return true
+// Remove a node from the list.
+//
+// Returns ErrNodeNotInList if the node does not belong to this list,
+// for example because it was already removed or belongs to another list.
+func (l *Doubly[T]) RemoveNode(n *DoublyNode[T]) error {
+	if !l.owns(n) {
+		return ErrNodeNotInList
 	}
+	l.removeNode(n)
+	return nil
+}
 
-	if i == l.len-1 {
-		l.remove(i, l.tail)
-		return true
+// Reverse the list in place.
+func (l *Doubly[T]) Reverse() {
+	for n := l.head; n != nil; n = n.prev {
+		n.next, n.prev = n.prev, n.next
 	}
+	l.head, l.tail = l.tail, l.head
+}
 
-	var n *DoublyNode[T]
-	if i < l.len/2 {
-		n = l.head
-		for j := 0; j < i; j++ {
-			n = n.next
-		}
+// Move all nodes of other into this list, directly after the given node.
+//
+// If at is nil, the nodes are inserted at the beginning of the list.
+//
+// This is O(1), node handles from other can be used with this list afterwards.
+// Other is left empty.
+//
+// Returns ErrNodeNotInList if at does not belong to this list,
+// or ErrSameList if other is this list.
+func (l *Doubly[T]) Splice(at *DoublyNode[T], other *Doubly[T]) error {
+	if other == l {
+		return ErrSameList
+	}
+	if at != nil && !l.owns(at) {
+		return ErrNodeNotInList
+	}
+	if other.len == 0 {
+		return nil
+	}
+
+	// Hand all nodes over at once, other starts afresh with a new owner.
+	if other.owner != nil {
+		other.owner.list = nil
+		other.owner.parent = l.self()
+		other.owner = nil
+	}
+
+	var first, last = other.head, other.tail
+	var next *DoublyNode[T]
+	if at == nil {
+		next = l.head
+		l.head = first
 	} else {
-		n = l.tail
-		for j := l.len - 1; j > i; j-- {
-			n = n.prev
-		}
+		next = at.next
+		at.next = first
 	}
-	if n != nil {
-		l.remove(i, n)
-		return true
+	first.prev = at
+	last.next = next
+	if next == nil {
+		l.tail = last
+	} else {
+		next.prev = last
 	}
-	return false
+
+	l.len += other.len
+	other.head = nil
+	other.tail = nil
+	other.len = 0
+	return nil
+}
+
+// Split the list before the given node.
+//
+// The node and all nodes after it are moved into a new list, which is returned.
+// If this list is pooled, the new list recycles its nodes into the same pool.
+//
+// Returns ErrNodeNotInList if the node does not belong to this list.
+func (l *Doubly[T]) Split(at *DoublyNode[T]) (*Doubly[T], error) {
+	if !l.owns(at) {
+		return nil, ErrNodeNotInList
+	}
+
+	var rest = &Doubly[T]{
+		head: at,
+		tail: l.tail,
+		pool: l.pool,
+	}
+	var owner = rest.self()
+	for n := at; n != nil; n = n.next {
+		n.owner = owner
+		rest.len++
+	}
+
+	l.tail = at.prev
+	if l.tail == nil {
+		l.head = nil
+	} else {
+		l.tail.next = nil
+	}
+	at.prev = nil
+	l.len -= rest.len
+	return rest, nil
 }
 
 // Returns the list as a slice.
@@ -152,13 +385,13 @@ func (l *Doubly[T]) ToSlice() []T {
 // Returns the number of values removed.
 func (l *Doubly[T]) RemoveIf(predicate func(T) bool) int {
 	var removed = 0
-	var i = 0
-	for n := l.head; n != nil; n = n.next {
+	for n := l.head; n != nil; {
+		var next = n.next
 		if predicate(n.value) {
-			l.remove(i, n)
+			l.removeNode(n)
 			removed++
 		}
-		i++
+		n = next
 	}
 	return removed
 }
@@ -167,13 +400,11 @@ func (l *Doubly[T]) RemoveIf(predicate func(T) bool) int {
 //
 // Returns true if the value was removed.
 func (l *Doubly[T]) Remove(predicate func(value T) bool) bool {
-	var i = 0
 	for n := l.head; n != nil; n = n.next {
 		if predicate(n.value) {
-			l.remove(i, n)
+			l.removeNode(n)
 			return true
 		}
-		i++
 	}
 	return false
 }
@@ -196,6 +427,7 @@ func (l *Doubly[T]) UnmarshalJSON(data []byte) error {
 }
 
 func (l *Doubly[T]) append(n *DoublyNode[T]) {
+	n.owner = l.self()
 	if l.head == nil && l.tail == nil {
 		l.head = n
 		l.tail = n
@@ -209,6 +441,7 @@ func (l *Doubly[T]) append(n *DoublyNode[T]) {
 }
 
 func (l *Doubly[T]) prepend(n *DoublyNode[T]) {
+	n.owner = l.self()
 	if l.head == nil && l.tail == nil {
 		l.head = n
 		l.tail = n
@@ -221,20 +454,88 @@ func (l *Doubly[T]) prepend(n *DoublyNode[T]) {
 	l.len++
 }
 
-// remove removes a node from the list.
-func (l *Doubly[T]) remove(i int, n *DoublyNode[T]) {
-	if i == 0 {
-		l.head = n.next
+// Links n directly before mark.
+func (l *Doubly[T]) insertBefore(n, mark *DoublyNode[T]) {
+	n.owner = l.self()
+	n.prev = mark.prev
+	n.next = mark
+	if mark.prev != nil {
+		mark.prev.next = n
+	} else {
+		l.head = n
 	}
-	if i == l.len-1 {
-		l.tail = n.prev
+	mark.prev = n
+	l.len++
+}
+
+// Links n directly after mark.
+func (l *Doubly[T]) insertAfter(n, mark *DoublyNode[T]) {
+	n.owner = l.self()
+	n.next = mark.next
+	n.prev = mark
+	if mark.next != nil {
+		mark.next.prev = n
+	} else {
+		l.tail = n
+	}
+	mark.next = n
+	l.len++
+}
+
+// Returns the owner shared by the nodes of this list.
+func (l *Doubly[T]) self() *doublyOwner[T] {
+	if l.owner == nil {
+		l.owner = &doublyOwner[T]{list: l}
+	}
+	return l.owner
+}
+
+// Reports whether n is a node of this list.
+func (l *Doubly[T]) owns(n *DoublyNode[T]) bool {
+	if n == nil || n.owner == nil {
+		return false
 	}
+	n.owner = n.owner.find()
+	return n.owner.list == l
+}
 
+// Unlinks n from the list, updating the head and tail by identity.
+func (l *Doubly[T]) unlink(n *DoublyNode[T]) {
 	if n.prev != nil {
 		n.prev.next = n.next
+	} else {
+		l.head = n.next
 	}
 	if n.next != nil {
 		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
 	}
+	n.prev = nil
+	n.next = nil
+	n.owner = nil
 	l.len--
 }
+
+// Returns the node at a given index, or nil if the index is out of range.
+//
+// Walks from whichever end of the list is nearest.
+func (l *Doubly[T]) nodeAt(i int) *DoublyNode[T] {
+	if i < 0 || i >= l.len {
+		return nil
+	}
+
+	var n *DoublyNode[T]
+	if i < l.len/2 {
+		n = l.head
+		for j := 0; j < i; j++ {
+			n = n.next
+		}
+	} else {
+		n = l.tail
+		for j := l.len - 1; j > i; j-- {
+			n = n.prev
+		}
+	}
+	return n
+}
@@ -5,6 +5,30 @@ type DoublyNode[T any] struct {
 	value T
 	next  *DoublyNode[T]
 	prev  *DoublyNode[T]
+	// leads to the list this node belongs to, nil once removed.
+	owner *doublyOwner[T]
+}
+
+// Identifies the list a node belongs to.
+//
+// Splice hands every node of one list to another in a single step,
+// by pointing the owner of the emptied list at the owner of the receiving list.
+// Only the owner at the end of the chain knows its list.
+type doublyOwner[T any] struct {
+	list   *Doubly[T]
+	parent *doublyOwner[T]
+}
+
+// Follows the chain to the owner which knows the list,
+// shortening the chain on the way so later lookups are faster.
+func (o *doublyOwner[T]) find() *doublyOwner[T] {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
 }
 
 // Returns the node's next pointer.
@@ -0,0 +1,13 @@
+package linkedlist
+
+import "errors"
+
+// Returned when a node is passed to a list it does not belong to,
+// or has already been removed from.
+var ErrNodeNotInList = errors.New("linkedlist: node does not belong to this list")
+
+// Returned when a list is spliced into itself.
+var ErrSameList = errors.New("linkedlist: cannot splice a list into itself")
+
+// Returned when an element is added to an intrusive list while it is still linked into a list.
+var ErrAlreadyInList = errors.New("linkedlist: element is already in a list")
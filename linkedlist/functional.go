@@ -0,0 +1,218 @@
+package linkedlist
+
+// List is implemented by *Singly[T], *Doubly[T] and *Unrolled[T].
+//
+// It allows the package-level helpers below to work on every list type.
+type List[T any] interface {
+	Len() int
+	String() string
+	Append(v T)
+	Prepend(v T)
+	Pop() T
+	Shift() T
+	Get(i int) (T, bool)
+	RemoveIndex(i int) bool
+	RemoveIf(predicate func(T) bool) int
+	ToSlice() []T
+	Reset()
+
+	// walk calls f for every value from head to tail, until f returns false.
+	walk(f func(T) bool)
+}
+
+func (l *Singly[T]) walk(f func(T) bool) {
+	for n := l.head; n != nil; n = n.next {
+		if !f(n.value) {
+			return
+		}
+	}
+}
+
+func (l *Doubly[T]) walk(f func(T) bool) {
+	for n := l.head; n != nil; n = n.next {
+		if !f(n.value) {
+			return
+		}
+	}
+}
+
+func (l *Doubly[T]) walkReverse(f func(T) bool) {
+	for n := l.tail; n != nil; n = n.prev {
+		if !f(n.value) {
+			return
+		}
+	}
+}
+
+// Returns an empty list of the same kind as l.
+func newLike[T, U any](l List[T]) List[U] {
+	switch l := l.(type) {
+	case *Singly[T]:
+		return new(Singly[U])
+	case *Doubly[T]:
+		return new(Doubly[U])
+	case *Unrolled[T]:
+		return &Unrolled[U]{nodeSize: l.nodeSize}
+	}
+	panic("linkedlist: unknown list type")
+}
+
+// Call f for every value in the list, from head to tail.
+//
+// Iteration stops when f returns false.
+func ForEach[T any](l List[T], f func(T) (continueLoop bool)) {
+	l.walk(f)
+}
+
+// Call f for every value in the list, from tail to head.
+//
+// Iteration stops when f returns false.
+func ForEachReverse[T any](l *Doubly[T], f func(T) (continueLoop bool)) {
+	l.walkReverse(f)
+}
+
+// Returns a new list with f applied to every value.
+//
+// The new list is of the same kind as l, for example a *Singly[U] for a *Singly[T].
+func Map[T, U any](l List[T], f func(T) U) List[U] {
+	var mapped = newLike[T, U](l)
+	l.walk(func(v T) bool {
+		mapped.Append(f(v))
+		return true
+	})
+	return mapped
+}
+
+// Returns a new list with the values for which the predicate returns true.
+//
+// The new list is of the same kind as l.
+func Filter[T any](l List[T], predicate func(T) bool) List[T] {
+	var filtered = newLike[T, T](l)
+	l.walk(func(v T) bool {
+		if predicate(v) {
+			filtered.Append(v)
+		}
+		return true
+	})
+	return filtered
+}
+
+// Fold the list into a single value, from head to tail.
+func Reduce[T, A any](l List[T], initial A, f func(acc A, v T) A) A {
+	var acc = initial
+	l.walk(func(v T) bool {
+		acc = f(acc, v)
+		return true
+	})
+	return acc
+}
+
+// Fold the list into a single value, from tail to head.
+func ReduceReverse[T, A any](l *Doubly[T], initial A, f func(acc A, v T) A) A {
+	var acc = initial
+	l.walkReverse(func(v T) bool {
+		acc = f(acc, v)
+		return true
+	})
+	return acc
+}
+
+// Returns the first value for which the predicate returns true.
+func Find[T any](l List[T], predicate func(T) bool) (v T, ok bool) {
+	l.walk(func(value T) bool {
+		if predicate(value) {
+			v, ok = value, true
+			return false
+		}
+		return true
+	})
+	return v, ok
+}
+
+// Returns the last value for which the predicate returns true.
+func FindLast[T any](l *Doubly[T], predicate func(T) bool) (v T, ok bool) {
+	l.walkReverse(func(value T) bool {
+		if predicate(value) {
+			v, ok = value, true
+			return false
+		}
+		return true
+	})
+	return v, ok
+}
+
+// Returns the index of the first occurrence of the value, or -1 if it is not present.
+func IndexOf[T comparable](l List[T], v T) int {
+	return IndexOfFunc(l, func(value T) bool {
+		return value == v
+	})
+}
+
+// Returns the index of the first value for which the predicate returns true, or -1.
+func IndexOfFunc[T any](l List[T], predicate func(T) bool) int {
+	var i, found = 0, -1
+	l.walk(func(value T) bool {
+		if predicate(value) {
+			found = i
+			return false
+		}
+		i++
+		return true
+	})
+	return found
+}
+
+// Returns the index of the last occurrence of the value, or -1 if it is not present.
+func LastIndexOf[T comparable](l *Doubly[T], v T) int {
+	return LastIndexOfFunc(l, func(value T) bool {
+		return value == v
+	})
+}
+
+// Returns the index of the last value for which the predicate returns true, or -1.
+func LastIndexOfFunc[T any](l *Doubly[T], predicate func(T) bool) int {
+	var i, found = l.len - 1, -1
+	l.walkReverse(func(value T) bool {
+		if predicate(value) {
+			found = i
+			return false
+		}
+		i--
+		return true
+	})
+	return found
+}
+
+// Report whether the list contains the value.
+func Contains[T comparable](l List[T], v T) bool {
+	return IndexOf(l, v) != -1
+}
+
+// Report whether the list contains the value, using eq to compare values.
+//
+// This works with uncomparable types.
+func ContainsFunc[T any](l List[T], v T, eq func(a, b T) bool) bool {
+	return IndexOfFunc(l, func(value T) bool {
+		return eq(value, v)
+	}) != -1
+}
+
+// Report whether the predicate returns true for any value.
+//
+// Returns false for an empty list.
+func Any[T any](l List[T], predicate func(T) bool) bool {
+	var _, ok = Find(l, predicate)
+	return ok
+}
+
+// Report whether the predicate returns true for all values.
+//
+// Returns true for an empty list.
+func All[T any](l List[T], predicate func(T) bool) bool {
+	var all = true
+	l.walk(func(v T) bool {
+		all = predicate(v)
+		return all
+	})
+	return all
+}
@@ -0,0 +1,154 @@
+package linkedlist_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+func newLists(values ...int) []linkedlist.List[int] {
+	var s, d = new(linkedlist.Singly[int]), new(linkedlist.Doubly[int])
+	for _, v := range values {
+		s.Append(v)
+		d.Append(v)
+	}
+	return []linkedlist.List[int]{s, d}
+}
+
+func TestMapFilterReduce(t *testing.T) {
+	for _, l := range newLists(1, 2, 3, 4, 5) {
+		var mapped = linkedlist.Map(l, strconv.Itoa)
+		if mapped.String() != "[1, 2, 3, 4, 5]" || mapped.Len() != 5 {
+			t.Fatalf("%T: unexpected mapped list %s", l, mapped)
+		}
+		switch l.(type) {
+		case *linkedlist.Singly[int]:
+			if _, ok := mapped.(*linkedlist.Singly[string]); !ok {
+				t.Fatalf("Expected a *Singly[string], got %T", mapped)
+			}
+		case *linkedlist.Doubly[int]:
+			if _, ok := mapped.(*linkedlist.Doubly[string]); !ok {
+				t.Fatalf("Expected a *Doubly[string], got %T", mapped)
+			}
+		}
+
+		var even = linkedlist.Filter(l, func(v int) bool { return v%2 == 0 })
+		if even.String() != "[2, 4]" {
+			t.Fatalf("%T: unexpected filtered list %s", l, even)
+		}
+
+		var sum = linkedlist.Reduce(l, 0, func(acc, v int) int { return acc + v })
+		if sum != 15 {
+			t.Fatalf("%T: expected sum 15, got %d", l, sum)
+		}
+
+		if l.Len() != 5 {
+			t.Fatalf("%T: expected the source list to be untouched", l)
+		}
+	}
+}
+
+func TestFindIndexContains(t *testing.T) {
+	for _, l := range newLists(3, 1, 4, 1, 5) {
+		if v, ok := linkedlist.Find(l, func(v int) bool { return v > 3 }); !ok || v != 4 {
+			t.Fatalf("%T: expected to find 4, got %d", l, v)
+		}
+		if _, ok := linkedlist.Find(l, func(v int) bool { return v > 5 }); ok {
+			t.Fatalf("%T: expected nothing to be found", l)
+		}
+		if i := linkedlist.IndexOf(l, 1); i != 1 {
+			t.Fatalf("%T: expected index 1, got %d", l, i)
+		}
+		if i := linkedlist.IndexOf(l, 9); i != -1 {
+			t.Fatalf("%T: expected index -1, got %d", l, i)
+		}
+		if i := linkedlist.IndexOfFunc(l, func(v int) bool { return v == 5 }); i != 4 {
+			t.Fatalf("%T: expected index 4, got %d", l, i)
+		}
+		if !linkedlist.Contains(l, 4) || linkedlist.Contains(l, 2) {
+			t.Fatalf("%T: unexpected Contains result", l)
+		}
+	}
+
+	type point struct {
+		xy []int // uncomparable
+	}
+	var points = new(linkedlist.Doubly[point])
+	points.Append(point{[]int{1, 2}})
+	points.Append(point{[]int{3, 4}})
+	var eq = func(a, b point) bool {
+		return a.xy[0] == b.xy[0] && a.xy[1] == b.xy[1]
+	}
+	if !linkedlist.ContainsFunc[point](points, point{[]int{3, 4}}, eq) {
+		t.Fatal("Expected {3, 4} to be found")
+	}
+	if linkedlist.ContainsFunc[point](points, point{[]int{4, 3}}, eq) {
+		t.Fatal("Expected {4, 3} not to be found")
+	}
+}
+
+func TestAnyAllForEach(t *testing.T) {
+	for _, l := range newLists(2, 4, 6, 7) {
+		if !linkedlist.Any(l, func(v int) bool { return v%2 != 0 }) {
+			t.Fatalf("%T: expected an odd value", l)
+		}
+		if linkedlist.All(l, func(v int) bool { return v%2 == 0 }) {
+			t.Fatalf("%T: expected not all values to be even", l)
+		}
+		if !linkedlist.All(l, func(v int) bool { return v > 0 }) {
+			t.Fatalf("%T: expected all values to be positive", l)
+		}
+
+		var seen []int
+		linkedlist.ForEach(l, func(v int) bool {
+			seen = append(seen, v)
+			return v < 4
+		})
+		if len(seen) != 2 || seen[1] != 4 {
+			t.Fatalf("%T: expected ForEach to stop at 4, got %v", l, seen)
+		}
+	}
+
+	for _, l := range newLists() {
+		if linkedlist.Any(l, func(int) bool { return true }) {
+			t.Fatalf("%T: expected Any to be false for an empty list", l)
+		}
+		if !linkedlist.All(l, func(int) bool { return false }) {
+			t.Fatalf("%T: expected All to be true for an empty list", l)
+		}
+	}
+}
+
+func TestReverseHelpers(t *testing.T) {
+	var d = new(linkedlist.Doubly[int])
+	for _, v := range []int{3, 1, 4, 1, 5} {
+		d.Append(v)
+	}
+
+	var seen []int
+	linkedlist.ForEachReverse(d, func(v int) bool {
+		seen = append(seen, v)
+		return v != 4
+	})
+	if len(seen) != 3 || seen[0] != 5 || seen[2] != 4 {
+		t.Fatalf("Expected [5 1 4], got %v", seen)
+	}
+
+	if i := linkedlist.LastIndexOf(d, 1); i != 3 {
+		t.Fatalf("Expected last index 3, got %d", i)
+	}
+	if i := linkedlist.LastIndexOf(d, 9); i != -1 {
+		t.Fatalf("Expected last index -1, got %d", i)
+	}
+	if v, ok := linkedlist.FindLast(d, func(v int) bool { return v < 4 }); !ok || v != 1 {
+		t.Fatalf("Expected to find 1, got %d", v)
+	}
+
+	var digits = linkedlist.ReduceReverse(d, "", func(acc string, v int) string {
+		return acc + strconv.Itoa(v)
+	})
+	if digits != "51413" {
+		t.Fatalf("Expected 51413, got %s", digits)
+	}
+}
@@ -0,0 +1,271 @@
+package linkedlist
+
+// Link holds the list pointers of an element in an Intrusive list.
+//
+// Embed it in a struct, parameterized with a pointer to that struct:
+//
+//	type Conn struct {
+//		linkedlist.Link[*Conn]
+//		addr string
+//	}
+//
+//	var idle linkedlist.Intrusive[*Conn]
+//	idle.PushBack(&Conn{addr: "localhost"})
+//
+// The zero value is an unlinked element.
+//
+// A struct can only embed one Link, so an element can be in at most one list at a time.
+type Link[E any] struct {
+	next E
+	prev E
+	// the list this element belongs to, nil when unlinked.
+	list any
+}
+
+func (l *Link[E]) link() *Link[E] {
+	return l
+}
+
+// Linked is implemented by pointers to structs which embed a Link.
+type Linked[E any] interface {
+	comparable
+	link() *Link[E]
+}
+
+// A doubly linked list which stores its pointers inside the elements themselves.
+//
+// No nodes are allocated, so adding an element which already lives on the heap is free.
+//
+// The zero value is an empty list ready to use.
+type Intrusive[E Linked[E]] struct {
+	head E
+	tail E
+	len  int
+}
+
+// Returns the length of the list.
+func (l *Intrusive[E]) Len() int {
+	return l.len
+}
+
+// Returns the first element, or nil if the list is empty.
+func (l *Intrusive[E]) Front() E {
+	return l.head
+}
+
+// Returns the last element, or nil if the list is empty.
+func (l *Intrusive[E]) Back() E {
+	return l.tail
+}
+
+// Returns the element after e, or nil if e is the last element or not in this list.
+func (l *Intrusive[E]) Next(e E) (next E) {
+	if !l.Contains(e) {
+		return
+	}
+	return e.link().next
+}
+
+// Returns the element before e, or nil if e is the first element or not in this list.
+func (l *Intrusive[E]) Prev(e E) (prev E) {
+	if !l.Contains(e) {
+		return
+	}
+	return e.link().prev
+}
+
+// Report whether e is an element of this list.
+func (l *Intrusive[E]) Contains(e E) bool {
+	var zero E
+	return e != zero && e.link().list == any(l)
+}
+
+// Add an element to the end of the list.
+//
+// Returns ErrAlreadyInList if the element is linked into a list.
+func (l *Intrusive[E]) PushBack(e E) error {
+	if e.link().list != nil {
+		return ErrAlreadyInList
+	}
+	l.insert(e, l.tail)
+	return nil
+}
+
+// Add an element to the beginning of the list.
+//
+// Returns ErrAlreadyInList if the element is linked into a list.
+func (l *Intrusive[E]) PushFront(e E) error {
+	if e.link().list != nil {
+		return ErrAlreadyInList
+	}
+	var zero E
+	l.insert(e, zero)
+	return nil
+}
+
+// Insert an element directly before mark.
+//
+// Returns ErrAlreadyInList if the element is linked into a list,
+// or ErrNodeNotInList if mark is not in this list.
+func (l *Intrusive[E]) InsertBefore(e, mark E) error {
+	if e.link().list != nil {
+		return ErrAlreadyInList
+	}
+	if !l.Contains(mark) {
+		return ErrNodeNotInList
+	}
+	l.insert(e, mark.link().prev)
+	return nil
+}
+
+// Insert an element directly after mark.
+//
+// Returns ErrAlreadyInList if the element is linked into a list,
+// or ErrNodeNotInList if mark is not in this list.
+func (l *Intrusive[E]) InsertAfter(e, mark E) error {
+	if e.link().list != nil {
+		return ErrAlreadyInList
+	}
+	if !l.Contains(mark) {
+		return ErrNodeNotInList
+	}
+	l.insert(e, mark)
+	return nil
+}
+
+// Remove an element from the list.
+//
+// Returns ErrNodeNotInList if the element is not in this list.
+func (l *Intrusive[E]) Remove(e E) error {
+	if !l.Contains(e) {
+		return ErrNodeNotInList
+	}
+	l.unlink(e)
+	return nil
+}
+
+// Remove and return the first element.
+//
+// Returns false if the list is empty.
+func (l *Intrusive[E]) PopFront() (e E, ok bool) {
+	if l.len == 0 {
+		return
+	}
+	e = l.head
+	l.unlink(e)
+	return e, true
+}
+
+// Remove and return the last element.
+//
+// Returns false if the list is empty.
+func (l *Intrusive[E]) PopBack() (e E, ok bool) {
+	if l.len == 0 {
+		return
+	}
+	e = l.tail
+	l.unlink(e)
+	return e, true
+}
+
+// Move an element to the beginning of the list.
+//
+// Returns ErrNodeNotInList if the element is not in this list.
+func (l *Intrusive[E]) MoveToFront(e E) error {
+	if !l.Contains(e) {
+		return ErrNodeNotInList
+	}
+	if l.head != e {
+		var zero E
+		l.unlink(e)
+		l.insert(e, zero)
+	}
+	return nil
+}
+
+// Move an element to the end of the list.
+//
+// Returns ErrNodeNotInList if the element is not in this list.
+func (l *Intrusive[E]) MoveToBack(e E) error {
+	if !l.Contains(e) {
+		return ErrNodeNotInList
+	}
+	if l.tail != e {
+		l.unlink(e)
+		l.insert(e, l.tail)
+	}
+	return nil
+}
+
+// Call f for every element, from front to back.
+//
+// Iteration stops when f returns false.
+//
+// It is safe to remove the current element from within f.
+func (l *Intrusive[E]) Each(f func(E) (continueLoop bool)) {
+	var zero E
+	for e := l.head; e != zero; {
+		var next = e.link().next
+		if !f(e) {
+			return
+		}
+		e = next
+	}
+}
+
+// Remove all elements from the list.
+func (l *Intrusive[E]) Reset() {
+	var zero E
+	for e := l.head; e != zero; {
+		var link = e.link()
+		e = link.next
+		*link = Link[E]{}
+	}
+	l.head = zero
+	l.tail = zero
+	l.len = 0
+}
+
+// Links e after prev, or at the front if prev is nil.
+func (l *Intrusive[E]) insert(e, prev E) {
+	var (
+		zero E
+		link = e.link()
+		next E
+	)
+	if prev == zero {
+		next = l.head
+		l.head = e
+	} else {
+		next = prev.link().next
+		prev.link().next = e
+	}
+	if next == zero {
+		l.tail = e
+	} else {
+		next.link().prev = e
+	}
+	link.prev = prev
+	link.next = next
+	link.list = l
+	l.len++
+}
+
+func (l *Intrusive[E]) unlink(e E) {
+	var (
+		zero E
+		link = e.link()
+	)
+	if link.prev == zero {
+		l.head = link.next
+	} else {
+		link.prev.link().next = link.next
+	}
+	if link.next == zero {
+		l.tail = link.prev
+	} else {
+		link.next.link().prev = link.prev
+	}
+	*link = Link[E]{}
+	l.len--
+}
@@ -0,0 +1,144 @@
+package linkedlist_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+type conn struct {
+	linkedlist.Link[*conn]
+	addr string
+}
+
+func intrusiveString(l *linkedlist.Intrusive[*conn]) string {
+	var addrs []string
+	l.Each(func(c *conn) bool {
+		addrs = append(addrs, c.addr)
+		return true
+	})
+	var backwards []string
+	for c := l.Back(); c != nil; c = l.Prev(c) {
+		backwards = append([]string{c.addr}, backwards...)
+	}
+	var forwards, reversed = strings.Join(addrs, ","), strings.Join(backwards, ",")
+	if forwards != reversed {
+		return "inconsistent: " + forwards + " / " + reversed
+	}
+	return forwards
+}
+
+func TestIntrusive(t *testing.T) {
+	var (
+		l       linkedlist.Intrusive[*conn]
+		a, b, c = &conn{addr: "a"}, &conn{addr: "b"}, &conn{addr: "c"}
+		d       = &conn{addr: "d"}
+	)
+
+	if err := l.PushBack(b); err != nil {
+		t.Fatal(err)
+	}
+	l.PushFront(a)
+	l.PushBack(d)
+	if err := l.InsertAfter(c, b); err != nil {
+		t.Fatal(err)
+	}
+	if s := intrusiveString(&l); s != "a,b,c,d" || l.Len() != 4 {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+
+	if err := l.PushBack(a); err != linkedlist.ErrAlreadyInList {
+		t.Fatalf("Expected ErrAlreadyInList, got %v", err)
+	}
+
+	var other linkedlist.Intrusive[*conn]
+	if err := other.PushBack(a); err != linkedlist.ErrAlreadyInList {
+		t.Fatalf("Expected ErrAlreadyInList for an element of another list, got %v", err)
+	}
+	if err := other.Remove(a); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList, got %v", err)
+	}
+
+	l.MoveToFront(d)
+	l.MoveToBack(a)
+	if s := intrusiveString(&l); s != "d,b,c,a" {
+		t.Fatalf("Unexpected list after moves: %s", s)
+	}
+
+	if err := l.Remove(c); err != nil {
+		t.Fatal(err)
+	}
+	if l.Contains(c) || l.Next(c) != nil {
+		t.Fatal("Expected c to be unlinked")
+	}
+	if err := other.PushBack(c); err != nil {
+		t.Fatalf("Expected a removed element to be reusable, got %v", err)
+	}
+	if err := l.InsertBefore(&conn{addr: "x"}, c); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList for a foreign mark, got %v", err)
+	}
+	l.InsertBefore(&conn{addr: "x"}, b)
+	if s := intrusiveString(&l); s != "d,x,b,a" {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+
+	if e, ok := l.PopFront(); !ok || e != d {
+		t.Fatalf("Expected to pop d, got %v", e)
+	}
+	if e, ok := l.PopBack(); !ok || e != a {
+		t.Fatalf("Expected to pop a, got %v", e)
+	}
+	if s := intrusiveString(&l); s != "x,b" || l.Len() != 2 {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+
+	// Removing the current element while iterating.
+	l.Each(func(e *conn) bool {
+		l.Remove(e)
+		return true
+	})
+	if l.Len() != 0 || l.Front() != nil || l.Back() != nil {
+		t.Fatal("Expected an empty list")
+	}
+	if _, ok := l.PopFront(); ok {
+		t.Fatal("Expected PopFront on an empty list to fail")
+	}
+
+	other.Reset()
+	if err := l.PushBack(c); err != nil {
+		t.Fatalf("Expected Reset to unlink elements, got %v", err)
+	}
+}
+
+func BenchmarkIntrusivePushPop(b *testing.B) {
+	var (
+		l     linkedlist.Intrusive[*conn]
+		conns = make([]conn, 64)
+	)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l.PushBack(&conns[i%len(conns)])
+		if l.Len() == len(conns) {
+			for l.Len() > 0 {
+				l.PopFront()
+			}
+		}
+	}
+}
+
+func BenchmarkDoublyPushPop(b *testing.B) {
+	var (
+		l     linkedlist.Doubly[*conn]
+		conns = make([]conn, 64)
+	)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l.Append(&conns[i%len(conns)])
+		if l.Len() == len(conns) {
+			for l.Len() > 0 {
+				l.Shift()
+			}
+		}
+	}
+}
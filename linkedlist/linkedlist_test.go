@@ -1,6 +1,8 @@
 package linkedlist_test
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/Nigel2392/go-datastructures/linkedlist"
@@ -61,3 +63,283 @@ func TestDoubly(t *testing.T) {
 
 	t.Log(d)
 }
+
+func TestDoublyPositional(t *testing.T) {
+	var d = new(linkedlist.Doubly[int])
+	for i := 0; i < 10; i++ {
+		d.Append(i)
+	}
+
+	for i := 0; i < 10; i++ {
+		if v, ok := d.Get(i); !ok || v != i {
+			t.Fatalf("Expected %d at index %d, got %d", i, i, v)
+		}
+	}
+	if _, ok := d.Get(10); ok {
+		t.Fatal("Expected Get(10) to be out of range")
+	}
+	if _, ok := d.Get(-1); ok {
+		t.Fatal("Expected Get(-1) to be out of range")
+	}
+
+	if !d.Set(7, 70) || d.Set(10, 100) {
+		t.Fatal("Expected Set(7) to succeed and Set(10) to fail")
+	}
+
+	if !d.InsertAt(0, -1) || !d.InsertAt(d.Len(), 10) || !d.InsertAt(5, 45) || d.InsertAt(100, 0) {
+		t.Fatal("Unexpected InsertAt result")
+	}
+	if d.String() != "[-1, 0, 1, 2, 3, 45, 4, 5, 6, 70, 8, 9, 10]" {
+		t.Fatalf("Unexpected list: %s", d)
+	}
+	if d.Head().Value() != -1 || d.Tail().Value() != 10 {
+		t.Fatalf("Unexpected head or tail: %d, %d", d.Head().Value(), d.Tail().Value())
+	}
+
+	var empty = new(linkedlist.Doubly[int])
+	if !empty.InsertAt(0, 1) || empty.Len() != 1 || empty.Head() != empty.Tail() {
+		t.Fatal("Expected InsertAt(0) on an empty list to append")
+	}
+}
+
+func TestDoublyHandles(t *testing.T) {
+	var d = new(linkedlist.Doubly[string])
+	var b = d.PushBack("b")
+	var a = d.PushFront("a")
+	var c = d.InsertAfter(b, "c")
+	var ab = d.InsertBefore(b, "ab")
+	if d.String() != "[a, ab, b, c]" {
+		t.Fatalf("Unexpected list: %s", d)
+	}
+
+	d.MoveToFront(c)
+	if d.String() != "[c, a, ab, b]" || d.Head() != c {
+		t.Fatalf("Unexpected list after MoveToFront: %s", d)
+	}
+
+	d.MoveToBack(c)
+	if d.String() != "[a, ab, b, c]" || d.Tail() != c {
+		t.Fatalf("Unexpected list after MoveToBack: %s", d)
+	}
+
+	d.MoveBefore(c, a)
+	if d.String() != "[c, a, ab, b]" || d.Head() != c {
+		t.Fatalf("Unexpected list after MoveBefore: %s", d)
+	}
+
+	d.MoveAfter(c, b)
+	if d.String() != "[a, ab, b, c]" || d.Tail() != c {
+		t.Fatalf("Unexpected list after MoveAfter: %s", d)
+	}
+
+	d.MoveAfter(a, ab)
+	d.MoveBefore(b, b)
+	if d.String() != "[ab, a, b, c]" || d.Head() != ab {
+		t.Fatalf("Unexpected list after MoveAfter: %s", d)
+	}
+
+	if d.Len() != 4 {
+		t.Fatalf("Expected length 4, got %d", d.Len())
+	}
+
+	var backwards []string
+	for n := d.Tail(); n != nil; n = n.Prev() {
+		backwards = append(backwards, n.Value())
+	}
+	if strings.Join(backwards, "") != "cbaab" {
+		t.Fatalf("Expected prev pointers to be consistent, got %v", backwards)
+	}
+}
+
+func TestSinglyAppend(t *testing.T) {
+	var s = new(linkedlist.Singly[int])
+	for i := 0; i < 5; i++ {
+		s.Append(i)
+	}
+	s.Prepend(-1)
+	if s.String() != "[-1, 0, 1, 2, 3, 4]" || s.Tail().Value() != 4 {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+
+	if v, ok := s.Get(3); !ok || v != 2 {
+		t.Fatalf("Expected 2 at index 3, got %d", v)
+	}
+	if v, ok := s.Get(5); !ok || v != 4 {
+		t.Fatalf("Expected 4 at index 5, got %d", v)
+	}
+	if _, ok := s.Get(6); ok {
+		t.Fatal("Expected Get(6) to be out of range")
+	}
+
+	if v := s.Pop(); v != 4 || s.Tail().Value() != 3 {
+		t.Fatalf("Expected to pop 4, got %d (tail %d)", v, s.Tail().Value())
+	}
+
+	var n = s.InsertAfter(s.Tail(), 10)
+	if s.Tail() != n {
+		t.Fatal("Expected InsertAfter(tail) to move the tail")
+	}
+	s.InsertAfter(s.Head(), 20)
+	if s.String() != "[-1, 20, 0, 1, 2, 3, 10]" {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+
+	if v, ok := s.RemoveAfter(s.Head()); !ok || v != 20 {
+		t.Fatalf("Expected to remove 20, got %d", v)
+	}
+	if _, ok := s.RemoveAfter(s.Tail()); ok {
+		t.Fatal("Expected RemoveAfter(tail) to fail")
+	}
+
+	// Removing the last node must update the tail.
+	if !s.Remove(10) || s.Tail().Value() != 3 {
+		t.Fatalf("Expected tail 3 after removing 10, got %s", s)
+	}
+	if !s.RemoveIndex(s.Len()-1) || s.Tail().Value() != 2 {
+		t.Fatalf("Expected tail 2 after RemoveIndex, got %s", s)
+	}
+	s.Append(5)
+	if s.String() != "[-1, 0, 1, 2, 5]" {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+
+	if removed := s.RemoveIf(func(v int) bool { return v%2 != 0 }); removed != 3 {
+		t.Fatalf("Expected 3 values removed, got %d", removed)
+	}
+	if s.String() != "[0, 2]" || s.Tail().Value() != 2 || s.Len() != 2 {
+		t.Fatalf("Unexpected list after RemoveIf: %s", s)
+	}
+
+	s.Shift()
+	s.Shift()
+	if s.Head() != nil || s.Tail() != nil {
+		t.Fatal("Expected head and tail to be nil for an empty list")
+	}
+	s.Append(1)
+	if s.Head() != s.Tail() || s.Len() != 1 {
+		t.Fatal("Expected a single node list")
+	}
+}
+
+func TestSinglyJSON(t *testing.T) {
+	var s = new(linkedlist.Singly[int])
+	for i := 0; i < 5; i++ {
+		s.Append(i)
+	}
+	var data, err = json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded = new(linkedlist.Singly[int])
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.String() != s.String() {
+		t.Fatalf("Expected %s after a JSON round-trip, got %s", s, decoded)
+	}
+	decoded.Append(5)
+	if decoded.Tail().Value() != 5 {
+		t.Fatal("Expected the decoded list to have a valid tail")
+	}
+}
+
+func TestRemoveNode(t *testing.T) {
+	var d, other = new(linkedlist.Doubly[int]), new(linkedlist.Doubly[int])
+	var first = d.PushBack(1)
+	var middle = d.PushBack(2)
+	var last = d.PushBack(3)
+	var foreign = other.PushBack(4)
+
+	if err := d.RemoveNode(foreign); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList for a foreign node, got %v", err)
+	}
+	if err := d.RemoveNode(middle); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.RemoveNode(middle); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList for a removed node, got %v", err)
+	}
+	if err := d.RemoveNode(last); err != nil || d.Tail() != first {
+		t.Fatalf("Expected the tail to be updated, got %v (%s)", err, d)
+	}
+	if err := d.RemoveNode(first); err != nil || d.Head() != nil || d.Tail() != nil {
+		t.Fatalf("Expected an empty list, got %v (%s)", err, d)
+	}
+	if d.Len() != 0 || other.Len() != 1 {
+		t.Fatalf("Expected lengths 0 and 1, got %d and %d", d.Len(), other.Len())
+	}
+	if d.InsertAfter(foreign, 5) != nil {
+		t.Fatal("Expected InsertAfter with a foreign node to fail")
+	}
+	d.MoveToFront(foreign)
+	if d.Len() != 0 || other.Head() != foreign {
+		t.Fatal("Expected MoveToFront with a foreign node to be ignored")
+	}
+
+	// A nil node never belongs to the list.
+	var pushed = d.PushBack(6)
+	if d.InsertBefore(nil, 7) != nil || d.InsertAfter(nil, 7) != nil {
+		t.Fatal("Expected inserting next to a nil node to fail")
+	}
+	d.MoveToFront(nil)
+	d.MoveToBack(nil)
+	d.MoveBefore(nil, pushed)
+	d.MoveBefore(pushed, nil)
+	d.MoveAfter(nil, pushed)
+	d.MoveAfter(pushed, nil)
+	if d.Len() != 1 || d.Head() != pushed || d.Tail() != pushed {
+		t.Fatalf("Expected nil nodes to be ignored, got %s", d)
+	}
+
+	var s, otherS = new(linkedlist.Singly[int]), new(linkedlist.Singly[int])
+	for i := 0; i < 4; i++ {
+		s.Append(i)
+	}
+	otherS.Append(10)
+
+	var handle = s.Head().Next()
+	var tail = s.Tail()
+	if err := s.RemoveNode(otherS.Head()); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList for a foreign node, got %v", err)
+	}
+	if s.InsertAfter(nil, 5) != nil {
+		t.Fatal("Expected InsertAfter with a nil node to fail")
+	}
+	if _, ok := s.RemoveAfter(nil); ok || s.Len() != 4 {
+		t.Fatal("Expected RemoveAfter with a nil node to fail")
+	}
+	if err := s.RemoveNode(tail); err != nil || s.Tail().Value() != 2 {
+		t.Fatalf("Expected the last node to be removed, got %v (%s)", err, s)
+	}
+	if err := s.RemoveNode(handle); err != nil {
+		t.Fatal(err)
+	}
+	if handle.Value() != 1 {
+		t.Fatalf("Expected the removed handle to keep its value, got %d", handle.Value())
+	}
+	if err := s.RemoveNode(handle); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList for a removed node, got %v", err)
+	}
+	if s.String() != "[0, 2]" || s.Len() != 2 || otherS.Len() != 1 {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+
+	var head = s.Head()
+	s.Reset()
+	if err := s.RemoveNode(head); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList after Reset, got %v", err)
+	}
+
+	var dHead = d.PushBack(7)
+	d.Reset()
+	var fresh = d.PushBack(8)
+	if err := d.RemoveNode(dHead); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList after Reset, got %v", err)
+	}
+	if d.InsertAfter(dHead, 9) != nil || d.Len() != 1 {
+		t.Fatal("Expected a node detached by Reset to be ignored")
+	}
+	if err := d.RemoveNode(fresh); err != nil || d.Len() != 0 {
+		t.Fatalf("Expected nodes added after Reset to belong to the list, got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package linkedlist
+
+// A bounded free list of nodes.
+//
+// Removed nodes are kept for reuse instead of being left to the garbage collector,
+// which keeps high-churn queues from allocating on every insert.
+type nodePool[N any] struct {
+	free []N
+}
+
+func newNodePool[N any](size int) *nodePool[N] {
+	if size <= 0 {
+		panic("linkedlist: pool size must be positive")
+	}
+	return &nodePool[N]{
+		free: make([]N, 0, size),
+	}
+}
+
+// Returns a recycled node, and false if none are available.
+func (p *nodePool[N]) get() (n N, ok bool) {
+	if p == nil || len(p.free) == 0 {
+		return
+	}
+	n = p.free[len(p.free)-1]
+	var zero N
+	p.free[len(p.free)-1] = zero
+	p.free = p.free[:len(p.free)-1]
+	return n, true
+}
+
+// Keep a node for reuse, unless the pool is full.
+//
+// The node must already be cleared by the caller.
+func (p *nodePool[N]) put(n N) {
+	if p == nil || len(p.free) == cap(p.free) {
+		return
+	}
+	p.free = append(p.free, n)
+}
+
+// Create a new doubly linked list which recycles up to size removed nodes.
+//
+// Nodes are recycled on Pop, Shift, Remove, RemoveIndex, RemoveIf, RemoveNode and Reset.
+// Their values are cleared so the pool does not keep garbage alive.
+//
+// Because nodes are reused, a handle to a removed node must not be used again:
+// it may already hold another value in this list.
+func NewDoublyPooled[T any](size int) *Doubly[T] {
+	return &Doubly[T]{
+		pool: newNodePool[*DoublyNode[T]](size),
+	}
+}
+
+// Create a new singly linked list which recycles up to size removed nodes.
+//
+// Nodes are recycled on Pop, Shift, Remove, RemoveAfter, RemoveIndex, RemoveIf, RemoveNode and Reset.
+// Their values are cleared so the pool does not keep garbage alive.
+//
+// Because nodes are reused, a handle to a removed node must not be used again:
+// it may already hold another value in this list.
+func NewSinglyPooled[T any](size int) *Singly[T] {
+	return &Singly[T]{
+		pool: newNodePool[*Node[T]](size),
+	}
+}
+
+func (l *Doubly[T]) newNode(v T) *DoublyNode[T] {
+	if n, ok := l.pool.get(); ok {
+		n.value = v
+		return n
+	}
+	return &DoublyNode[T]{value: v}
+}
+
+// Unlinks a node which is removed for good, and recycles it if the list is pooled.
+func (l *Doubly[T]) removeNode(n *DoublyNode[T]) {
+	l.unlink(n)
+	if l.pool != nil {
+		var zero T
+		n.value = zero
+		l.pool.put(n)
+	}
+}
+
+func (l *Singly[T]) newNode(v T) *Node[T] {
+	if n, ok := l.pool.get(); ok {
+		n.value = v
+		return n
+	}
+	return &Node[T]{value: v}
+}
+
+// Recycles a node which was removed for good, if the list is pooled.
+func (l *Singly[T]) release(n *Node[T]) {
+	if l.pool != nil {
+		var zero T
+		n.value = zero
+		l.pool.put(n)
+	}
+}
@@ -0,0 +1,177 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+func TestDoublyPooled(t *testing.T) {
+	var d = linkedlist.NewDoublyPooled[*int](4)
+	var v = new(int)
+	var n = d.PushBack(v)
+	d.Shift()
+	if n.Value() != nil {
+		t.Fatal("Expected the recycled node to be cleared")
+	}
+	if reused := d.PushBack(v); reused != n {
+		t.Fatal("Expected the removed node to be reused")
+	}
+
+	for i := 0; i < 10; i++ {
+		d.Append(new(int))
+	}
+	d.RemoveIf(func(p *int) bool { return p != v })
+	d.Reset()
+	if d.Len() != 0 || d.Head() != nil {
+		t.Fatal("Expected an empty list")
+	}
+
+	for i := 0; i < 10; i++ {
+		var x = i
+		d.Append(&x)
+	}
+	var i = 0
+	for n := d.Head(); n != nil; n = n.Next() {
+		if *n.Value() != i {
+			t.Fatalf("Expected %d, got %d", i, *n.Value())
+		}
+		i++
+	}
+	checkDoubly(t, d)
+}
+
+func TestDoublyPooledSplitMerge(t *testing.T) {
+	var d = linkedlist.NewDoublyPooled[int](4)
+	for i := 0; i < 4; i++ {
+		d.Append(i)
+	}
+	var rest, err = d.Split(d.Head().Next().Next())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The split off list recycles into the same pool.
+	var n = rest.Tail()
+	rest.Pop()
+	if reused := d.PushBack(10); reused != n {
+		t.Fatal("Expected the node removed from the split list to be reused")
+	}
+
+	// So does a merge of two lists sharing a pool.
+	var merged = linkedlist.MergeSorted(d, rest, func(a, b int) bool { return a < b })
+	n = merged.Head()
+	merged.Shift()
+	if reused := d.PushBack(20); reused != n {
+		t.Fatal("Expected the node removed from the merged list to be reused")
+	}
+
+	// But not a merge of lists with different pools.
+	var other = linkedlist.NewDoublyPooled[int](4)
+	other.Append(1)
+	merged = linkedlist.MergeSorted(d, other, func(a, b int) bool { return a < b })
+	n = merged.Head()
+	merged.Shift()
+	if reused := d.PushBack(30); reused == n {
+		t.Fatal("Expected a merge of differently pooled lists not to recycle")
+	}
+	checkDoubly(t, merged)
+}
+
+func TestSinglyPooled(t *testing.T) {
+	var s = linkedlist.NewSinglyPooled[string](4)
+	s.Append("a")
+	var n = s.Head()
+	s.Shift()
+	if n.Value() != "" {
+		t.Fatal("Expected the recycled node to be cleared")
+	}
+	s.Append("b")
+	if s.Head() != n {
+		t.Fatal("Expected the removed node to be reused")
+	}
+	s.InsertAfter(s.Head(), "c")
+	s.Append("d")
+	s.Pop()
+	s.RemoveAfter(s.Head())
+	if s.String() != "[b]" || s.Tail() != s.Head() {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+	for i := 0; i < 10; i++ {
+		s.Prepend("x")
+	}
+	s.Reset()
+	s.Append("e")
+	if s.String() != "[e]" || s.Len() != 1 {
+		t.Fatalf("Unexpected list: %s", s)
+	}
+}
+
+func TestSinglyPooledSplitAfter(t *testing.T) {
+	var s = linkedlist.NewSinglyPooled[int](4)
+	for i := 0; i < 4; i++ {
+		s.Append(i)
+	}
+	var rest, err = s.SplitAfter(s.Head().Next())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The split off list recycles into the same pool.
+	var n = rest.Head()
+	rest.Shift()
+	s.Append(10)
+	if s.Tail() != n {
+		t.Fatal("Expected the node removed from the split list to be reused")
+	}
+
+	// Even when nothing is split off.
+	var empty, _ = s.SplitAfter(s.Tail())
+	empty.Append(20)
+	n = empty.Head()
+	empty.Shift()
+	rest.Append(30)
+	if rest.Tail() != n {
+		t.Fatal("Expected the node removed from the empty split list to be reused")
+	}
+}
+
+const queueDepth = 64
+
+func benchmarkDoublyQueue(b *testing.B, d *linkedlist.Doubly[int]) {
+	b.ReportAllocs()
+	for i := 0; i < queueDepth; i++ {
+		d.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.Append(i)
+		d.Shift()
+	}
+}
+
+func BenchmarkDoublyQueue(b *testing.B) {
+	benchmarkDoublyQueue(b, new(linkedlist.Doubly[int]))
+}
+
+func BenchmarkDoublyPooledQueue(b *testing.B) {
+	benchmarkDoublyQueue(b, linkedlist.NewDoublyPooled[int](queueDepth))
+}
+
+func benchmarkSinglyQueue(b *testing.B, s *linkedlist.Singly[int]) {
+	b.ReportAllocs()
+	for i := 0; i < queueDepth; i++ {
+		s.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Append(i)
+		s.Shift()
+	}
+}
+
+func BenchmarkSinglyQueue(b *testing.B) {
+	benchmarkSinglyQueue(b, new(linkedlist.Singly[int]))
+}
+
+func BenchmarkSinglyPooledQueue(b *testing.B) {
+	benchmarkSinglyQueue(b, linkedlist.NewSinglyPooled[int](queueDepth))
+}
@@ -17,7 +17,10 @@ import (
 // You can only use RemoveIndex(index).
 type Singly[T any] struct {
 	head *Node[T]
+	tail *Node[T]
 	len  int
+	// recycles removed nodes, nil unless created with NewSinglyPooled.
+	pool *nodePool[*Node[T]]
 }
 
 // Returns the length of the list.
@@ -44,12 +47,71 @@ func (l *Singly[T]) Head() *Node[T] {
 	return l.head
 }
 
+// Returns the tail of the list.
+func (l *Singly[T]) Tail() *Node[T] {
+	return l.tail
+}
+
 // Prepend a value to the beginning of the list.
 func (l *Singly[T]) Prepend(v T) {
-	var n = &Node[T]{value: v}
+	var n = l.newNode(v)
 	l.prepend(n)
 }
 
+// Append a value to the end of the list.
+func (l *Singly[T]) Append(v T) {
+	var n = l.newNode(v)
+	l.append(n)
+}
+
+// Insert a value after the given node.
+//
+// Returns the new node, or nil if the mark does not belong to this list.
+func (l *Singly[T]) InsertAfter(mark *Node[T], v T) *Node[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	var n = l.newNode(v)
+	n.next = mark.next
+	n.list = l
+	mark.next = n
+	if l.tail == mark {
+		l.tail = n
+	}
+	l.len++
+	return n
+}
+
+// Remove the node after the given node.
+//
+// Returns the removed value, and false if the given node is the tail
+// or does not belong to this list.
+func (l *Singly[T]) RemoveAfter(mark *Node[T]) (v T, ok bool) {
+	if !l.owns(mark) || mark.next == nil {
+		return
+	}
+	v = mark.next.value
+	l.remove(mark, mark.next)
+	return v, true
+}
+
+// Returns the value at a given index.
+//
+// Returns false if the index is out of range.
+func (l *Singly[T]) Get(i int) (v T, ok bool) {
+	if i < 0 || i >= l.len {
+		return
+	}
+	if i == l.len-1 {
+		return l.tail.value, true
+	}
+	var n = l.head
+	for j := 0; j < i; j++ {
+		n = n.next
+	}
+	return n.value, true
+}
+
 // Shift a value from the beginning of the list.
 //
 // Returns the value that was shifted.
@@ -58,13 +120,37 @@ func (l *Singly[T]) Shift() T {
 		panic("cannot shift from an empty list")
 	}
 	var v = l.head.value
-	l.RemoveIndex(0)
+	l.remove(nil, l.head)
+	return v
+}
+
+// Pop a value from the end of the list.
+//
+// This walks the list to find the node before the tail, which is O(n).
+//
+// Returns the value that was popped.
+func (l *Singly[T]) Pop() T {
+	if l.len == 0 {
+		panic("cannot pop from an empty list")
+	}
+	var v = l.tail.value
+	l.RemoveIndex(l.len - 1)
 	return v
 }
 
 // Reset the list.
+//
+// Detaches all nodes, so handles held by the caller can no longer be used with this list.
 func (l *Singly[T]) Reset() {
+	for n := l.head; n != nil; {
+		var next = n.next
+		n.next = nil
+		n.list = nil
+		l.release(n)
+		n = next
+	}
 	l.head = nil
+	l.tail = nil
 	l.len = 0
 }
 
@@ -77,13 +163,13 @@ func (l *Singly[T]) Remove(v T) bool {
 	if l.len == 0 {
 		return false
 	}
-	var i = 0
+	var prev *Node[T]
 	for n := l.head; n != nil; n = n.next {
 		if any(n.value) == any(v) { // panic on comparison of uncomparable types.
-			l.remove(i, n)
+			l.remove(prev, n)
 			return true
 		}
-		i++
+		prev = n
 	}
 	return false
 }
@@ -95,20 +181,34 @@ func (l *Singly[T]) RemoveIndex(i int) bool {
 	}
 
 	if i == 0 {
-		l.remove(i, l.head)
+		l.remove(nil, l.head)
 		return true
 	}
 
-	var n *Node[T]
-	n = l.head
-	for j := 0; j < i; j++ {
-		n = n.next
+	var prev = l.head
+	for j := 1; j < i; j++ {
+		prev = prev.next
 	}
-	if n != nil {
-		l.remove(i, n)
-		return true
+	l.remove(prev, prev.next)
+	return true
+}
+
+// Remove a node from the list.
+//
+// Removing the head is O(1), any other node requires a walk to find its predecessor.
+//
+// Returns ErrNodeNotInList if the node does not belong to this list,
+// for example because it was already removed or belongs to another list.
+func (l *Singly[T]) RemoveNode(n *Node[T]) error {
+	if !l.owns(n) {
+		return ErrNodeNotInList
 	}
-	return false
+	var prev *Node[T]
+	for cur := l.head; cur != n; cur = cur.next {
+		prev = cur
+	}
+	l.remove(prev, n)
+	return nil
 }
 
 // Remove a value from the list if the predicate returns true.
@@ -116,17 +216,62 @@ func (l *Singly[T]) RemoveIndex(i int) bool {
 // Returns the number of values removed.
 func (l *Singly[T]) RemoveIf(predicate func(T) bool) int {
 	var removed = 0
-	var i = 0
-	for n := l.head; n != nil; n = n.next {
+	var prev *Node[T]
+	for n := l.head; n != nil; {
+		var next = n.next
 		if predicate(n.value) {
-			l.remove(i, n)
+			l.remove(prev, n)
 			removed++
+		} else {
+			prev = n
 		}
-		i++
+		n = next
 	}
 	return removed
 }
 
+// Reverse the list in place.
+func (l *Singly[T]) Reverse() {
+	var prev *Node[T]
+	l.tail = l.head
+	for n := l.head; n != nil; {
+		var next = n.next
+		n.next = prev
+		prev = n
+		n = next
+	}
+	l.head = prev
+}
+
+// Split the list after the given node.
+//
+// All nodes after it are moved into a new list, which is returned.
+// If this list is pooled, the new list recycles its nodes into the same pool.
+//
+// Returns ErrNodeNotInList if the node does not belong to this list.
+func (l *Singly[T]) SplitAfter(at *Node[T]) (*Singly[T], error) {
+	if !l.owns(at) {
+		return nil, ErrNodeNotInList
+	}
+
+	var rest = &Singly[T]{pool: l.pool}
+	if at.next == nil {
+		return rest, nil
+	}
+
+	rest.head = at.next
+	rest.tail = l.tail
+	for n := rest.head; n != nil; n = n.next {
+		n.list = rest
+		rest.len++
+	}
+
+	at.next = nil
+	l.tail = at
+	l.len -= rest.len
+	return rest, nil
+}
+
 // Returns the list as a slice.
 func (l *Singly[T]) ToSlice() []T {
 	if l.len == 0 {
@@ -153,14 +298,21 @@ func (l *Singly[T]) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	for _, v := range slice {
-		l.Prepend(v)
+		l.Append(v)
 	}
 	return nil
 }
 
+// Reports whether n is a node of this list.
+func (l *Singly[T]) owns(n *Node[T]) bool {
+	return n != nil && n.list == l
+}
+
 func (l *Singly[T]) prepend(n *Node[T]) {
+	n.list = l
 	if l.head == nil {
 		l.head = n
+		l.tail = n
 	} else {
 		var oldHead = l.head
 		l.head = n
@@ -169,15 +321,32 @@ func (l *Singly[T]) prepend(n *Node[T]) {
 	l.len++
 }
 
+func (l *Singly[T]) append(n *Node[T]) {
+	n.list = l
+	if l.tail == nil {
+		l.head = n
+		l.tail = n
+	} else {
+		l.tail.next = n
+		l.tail = n
+	}
+	l.len++
+}
+
 // remove removes a node from the list.
-func (l *Singly[T]) remove(i int, n *Node[T]) {
-	if i == 0 {
+//
+// prev is the node before n, or nil if n is the head.
+func (l *Singly[T]) remove(prev, n *Node[T]) {
+	if prev == nil {
 		l.head = n.next
 	} else {
-		if n.next != nil {
-			*n = *n.next
-		}
+		prev.next = n.next
 	}
+	if l.tail == n {
+		l.tail = prev
+	}
+	n.next = nil
+	n.list = nil
 	l.len--
-
+	l.release(n)
 }
@@ -4,6 +4,8 @@ package linkedlist
 type Node[T any] struct {
 	value T
 	next  *Node[T]
+	// the list this node belongs to, nil once removed.
+	list *Singly[T]
 }
 
 // Returns the node's next pointer.
@@ -0,0 +1,139 @@
+package linkedlist
+
+// Implemented by *Node[T] and *DoublyNode[T], so the merge sort only has to be written once.
+//
+// Only the next pointers are touched, a doubly linked list fixes up its prev pointers afterwards.
+type sortable[T any, N any] interface {
+	comparable
+	getNext() N
+	setNext(N)
+	getValue() T
+}
+
+func (n *Node[T]) getNext() *Node[T]                 { return n.next }
+func (n *Node[T]) setNext(next *Node[T])             { n.next = next }
+func (n *Node[T]) getValue() T                       { return n.value }
+func (n *DoublyNode[T]) getNext() *DoublyNode[T]     { return n.next }
+func (n *DoublyNode[T]) setNext(next *DoublyNode[T]) { n.next = next }
+func (n *DoublyNode[T]) getValue() T                 { return n.value }
+
+// Bottom-up merge sort over the next pointers.
+//
+// Merges runs of doubling size until a single run remains,
+// which needs no recursion and no extra memory.
+//
+// The sort is stable: equal values keep their relative order.
+func mergeSort[T any, N sortable[T, N]](head N, less func(a, b T) bool) (newHead, newTail N) {
+	var zero N
+	if head == zero {
+		return zero, zero
+	}
+
+	for size := 1; ; size *= 2 {
+		var (
+			p       = head
+			tail    = zero
+			merges  = 0
+			takeP   bool
+			element N
+		)
+		head = zero
+
+		for p != zero {
+			merges++
+
+			// q starts size elements after p
+			var q, pSize = p, 0
+			for pSize < size && q != zero {
+				pSize++
+				q = q.getNext()
+			}
+			var qSize = size
+
+			for pSize > 0 || (qSize > 0 && q != zero) {
+				switch {
+				case pSize == 0:
+					takeP = false
+				case qSize == 0 || q == zero:
+					takeP = true
+				default:
+					// take from p on ties to keep the sort stable
+					takeP = !less(q.getValue(), p.getValue())
+				}
+
+				if takeP {
+					element, p = p, p.getNext()
+					pSize--
+				} else {
+					element, q = q, q.getNext()
+					qSize--
+				}
+
+				if tail != zero {
+					tail.setNext(element)
+				} else {
+					head = element
+				}
+				tail = element
+			}
+			p = q
+		}
+		tail.setNext(zero)
+
+		if merges <= 1 {
+			return head, tail
+		}
+	}
+}
+
+// Sort the list in place, using less to compare values.
+//
+// This is a stable merge sort which relinks the existing nodes,
+// so node handles stay valid and nothing is allocated.
+func (l *Doubly[T]) Sort(less func(a, b T) bool) {
+	l.head, l.tail = mergeSort[T](l.head, less)
+	var prev *DoublyNode[T]
+	for n := l.head; n != nil; n = n.next {
+		n.prev = prev
+		prev = n
+	}
+}
+
+// Sort the list in place, using less to compare values.
+//
+// This is a stable merge sort which relinks the existing nodes,
+// so node handles stay valid and nothing is allocated.
+func (l *Singly[T]) Sort(less func(a, b T) bool) {
+	l.head, l.tail = mergeSort[T](l.head, less)
+}
+
+// Merge two sorted lists into a new sorted list.
+//
+// The nodes of a and b are moved into the new list, leaving a and b empty.
+//
+// The merge is stable: on equal values, values from a come first.
+//
+// If a and b recycle nodes into the same pool, so does the new list.
+func MergeSorted[T any](a, b *Doubly[T], less func(a, b T) bool) *Doubly[T] {
+	var merged = new(Doubly[T])
+	if a.pool == b.pool {
+		merged.pool = a.pool
+	}
+	for a.head != nil && b.head != nil {
+		var from = a
+		if less(b.head.value, a.head.value) {
+			from = b
+		}
+		var n = from.head
+		from.unlink(n)
+		merged.append(n)
+	}
+	for _, rest := range [2]*Doubly[T]{a, b} {
+		for rest.head != nil {
+			var n = rest.head
+			rest.unlink(n)
+			merged.append(n)
+		}
+	}
+	return merged
+}
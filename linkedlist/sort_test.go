@@ -0,0 +1,218 @@
+package linkedlist_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+type pair struct {
+	key, order int
+}
+
+func checkDoubly[T any](t *testing.T, d *linkedlist.Doubly[T]) {
+	t.Helper()
+	var count int
+	var prev *linkedlist.DoublyNode[T]
+	for n := d.Head(); n != nil; n = n.Next() {
+		if n.Prev() != prev {
+			t.Fatalf("Inconsistent prev pointer at index %d", count)
+		}
+		prev = n
+		count++
+	}
+	if d.Tail() != prev || count != d.Len() {
+		t.Fatalf("Inconsistent tail or length: counted %d, Len() %d", count, d.Len())
+	}
+}
+
+func TestSort(t *testing.T) {
+	var r = rand.New(rand.NewSource(1))
+	for _, size := range []int{0, 1, 2, 3, 7, 64, 1000} {
+		var (
+			want = make([]pair, size)
+			d    = new(linkedlist.Doubly[pair])
+			s    = new(linkedlist.Singly[pair])
+		)
+		for i := range want {
+			want[i] = pair{key: r.Intn(10), order: i}
+			d.Append(want[i])
+			s.Append(want[i])
+		}
+		sort.SliceStable(want, func(i, j int) bool {
+			return want[i].key < want[j].key
+		})
+
+		var less = func(a, b pair) bool { return a.key < b.key }
+		d.Sort(less)
+		s.Sort(less)
+		checkDoubly(t, d)
+
+		var got = d.ToSlice()
+		var gotS = s.ToSlice()
+		for i := range want {
+			if got[i] != want[i] || gotS[i] != want[i] {
+				t.Fatalf("size %d: expected a stable sort, index %d: want %v, got %v and %v", size, i, want[i], got[i], gotS[i])
+			}
+		}
+		if size > 0 && s.Tail().Value() != want[size-1] {
+			t.Fatalf("size %d: expected the Singly tail to be updated", size)
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	var d = new(linkedlist.Doubly[int])
+	var s = new(linkedlist.Singly[int])
+	d.Reverse()
+	s.Reverse()
+	for i := 0; i < 5; i++ {
+		d.Append(i)
+		s.Append(i)
+	}
+	d.Reverse()
+	s.Reverse()
+	checkDoubly(t, d)
+	if d.String() != "[4, 3, 2, 1, 0]" || s.String() != "[4, 3, 2, 1, 0]" {
+		t.Fatalf("Unexpected reversed lists: %s, %s", d, s)
+	}
+	s.Append(-1)
+	if s.String() != "[4, 3, 2, 1, 0, -1]" {
+		t.Fatalf("Expected the Singly tail to be updated, got %s", s)
+	}
+}
+
+func TestSpliceSplit(t *testing.T) {
+	var a, b = new(linkedlist.Doubly[int]), new(linkedlist.Doubly[int])
+	var one = a.PushBack(1)
+	a.PushBack(4)
+	var two = b.PushBack(2)
+	b.PushBack(3)
+
+	if err := a.Splice(one, b); err != nil {
+		t.Fatal(err)
+	}
+	checkDoubly(t, a)
+	if a.String() != "[1, 2, 3, 4]" || b.Len() != 0 || b.Head() != nil {
+		t.Fatalf("Unexpected lists after Splice: %s, %s", a, b)
+	}
+
+	// Moved nodes belong to their new list.
+	if err := b.RemoveNode(two); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList, got %v", err)
+	}
+
+	var c = new(linkedlist.Doubly[int])
+	c.Append(0)
+	if err := a.Splice(nil, c); err != nil {
+		t.Fatal(err)
+	}
+	c.Append(5)
+	if err := a.Splice(a.Tail(), c); err != nil {
+		t.Fatal(err)
+	}
+	checkDoubly(t, a)
+	if a.String() != "[0, 1, 2, 3, 4, 5]" {
+		t.Fatalf("Unexpected list after Splice: %s", a)
+	}
+	if err := a.Splice(nil, a); err != linkedlist.ErrSameList {
+		t.Fatalf("Expected ErrSameList, got %v", err)
+	}
+
+	var rest, err = a.Split(two)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDoubly(t, a)
+	checkDoubly(t, rest)
+	if a.String() != "[0, 1]" || rest.String() != "[2, 3, 4, 5]" {
+		t.Fatalf("Unexpected lists after Split: %s, %s", a, rest)
+	}
+	if err := rest.RemoveNode(two); err != nil {
+		t.Fatalf("Expected the split node to belong to the new list, got %v", err)
+	}
+
+	var all, _ = a.Split(a.Head())
+	if a.Len() != 0 || a.Head() != nil || a.Tail() != nil || all.Len() != 2 {
+		t.Fatalf("Expected splitting at the head to move everything, got %s, %s", a, all)
+	}
+	if _, err := a.Split(all.Head()); err != linkedlist.ErrNodeNotInList {
+		t.Fatalf("Expected ErrNodeNotInList, got %v", err)
+	}
+
+	var s = new(linkedlist.Singly[int])
+	for i := 0; i < 5; i++ {
+		s.Append(i)
+	}
+	var sRest, sErr = s.SplitAfter(s.Head().Next())
+	if sErr != nil {
+		t.Fatal(sErr)
+	}
+	if s.String() != "[0, 1]" || sRest.String() != "[2, 3, 4]" || s.Len() != 2 || sRest.Len() != 3 {
+		t.Fatalf("Unexpected lists after SplitAfter: %s, %s", s, sRest)
+	}
+	s.Append(9)
+	sRest.Append(10)
+	if s.String() != "[0, 1, 9]" || sRest.String() != "[2, 3, 4, 10]" {
+		t.Fatalf("Expected valid tails after SplitAfter: %s, %s", s, sRest)
+	}
+}
+
+func TestSpliceChain(t *testing.T) {
+	var x, y, z = new(linkedlist.Doubly[int]), new(linkedlist.Doubly[int]), new(linkedlist.Doubly[int])
+	var fromX = x.PushBack(1)
+	var fromY = y.PushBack(2)
+	if err := y.Splice(nil, x); err != nil {
+		t.Fatal(err)
+	}
+	// Lists which were spliced away can be used again.
+	var laterX = x.PushBack(3)
+	if err := z.Splice(nil, y); err != nil {
+		t.Fatal(err)
+	}
+	var laterY = y.PushBack(4)
+
+	for _, n := range []*linkedlist.DoublyNode[int]{fromX, fromY} {
+		if y.RemoveNode(n) != linkedlist.ErrNodeNotInList || x.RemoveNode(n) != linkedlist.ErrNodeNotInList {
+			t.Fatalf("Expected %d to only belong to the last list", n.Value())
+		}
+	}
+	if z.RemoveNode(laterX) != linkedlist.ErrNodeNotInList || z.RemoveNode(laterY) != linkedlist.ErrNodeNotInList {
+		t.Fatal("Expected nodes added after the splices to stay in their own lists")
+	}
+	if z.RemoveNode(fromX) != nil || z.RemoveNode(fromY) != nil || z.Len() != 0 {
+		t.Fatalf("Expected the spliced nodes to belong to the last list, got %s", z)
+	}
+	if x.Len() != 1 || y.Len() != 1 {
+		t.Fatalf("Expected one value left in x and y, got %s and %s", x, y)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	var a, b = new(linkedlist.Doubly[pair]), new(linkedlist.Doubly[pair])
+	for _, k := range []int{1, 3, 3, 7} {
+		a.Append(pair{k, 0})
+	}
+	for _, k := range []int{0, 3, 8} {
+		b.Append(pair{k, 1})
+	}
+	var merged = linkedlist.MergeSorted(a, b, func(x, y pair) bool {
+		return x.key < y.key
+	})
+	checkDoubly(t, merged)
+	var want = []pair{{0, 1}, {1, 0}, {3, 0}, {3, 0}, {3, 1}, {7, 0}, {8, 1}}
+	var got = merged.ToSlice()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected %v, got %v", want, got)
+		}
+	}
+	if a.Len() != 0 || b.Len() != 0 {
+		t.Fatal("Expected the merged lists to be empty")
+	}
+}
@@ -0,0 +1,392 @@
+package linkedlist
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const defaultUnrolledNodeSize = 32
+
+// A node in an unrolled linked list, holding up to nodeSize values.
+type unrolledNode[T any] struct {
+	items []T
+	next  *unrolledNode[T]
+	prev  *unrolledNode[T]
+}
+
+// An unrolled linked list.
+//
+// Every node holds a small array of values instead of a single value,
+// so iterating is almost as fast as iterating a slice,
+// while inserting in the middle only shifts the values of a single node.
+//
+// Nodes are split when they overflow and merged with a neighbour when they drop below half full,
+// so every node except a lone head stays at least half full.
+//
+// The zero value is an empty list with the default node size, ready to use.
+type Unrolled[T any] struct {
+	head     *unrolledNode[T]
+	tail     *unrolledNode[T]
+	len      int
+	nodeSize int
+}
+
+// Create a new unrolled linked list with the given number of values per node.
+//
+// The node size must be at least 2.
+func NewUnrolled[T any](nodeSize int) *Unrolled[T] {
+	if nodeSize < 2 {
+		panic(fmt.Sprintf("NewUnrolled[T] requires a node size of at least 2, %d given", nodeSize))
+	}
+	return &Unrolled[T]{nodeSize: nodeSize}
+}
+
+// Returns the length of the list.
+func (l *Unrolled[T]) Len() int {
+	return l.len
+}
+
+// Returns the list as a string.
+func (l *Unrolled[T]) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	var i = 0
+	l.walk(func(v T) bool {
+		fmt.Fprintf(&b, "%v", v)
+		if i != l.len-1 {
+			b.WriteString(", ")
+		}
+		i++
+		return true
+	})
+	b.WriteString("]")
+	return b.String()
+}
+
+// Append a value to the end of the list.
+func (l *Unrolled[T]) Append(v T) {
+	if l.tail == nil {
+		l.pushNode(nil)
+	}
+	l.insert(l.tail, len(l.tail.items), v)
+}
+
+// Prepend a value to the beginning of the list.
+func (l *Unrolled[T]) Prepend(v T) {
+	if l.head == nil {
+		l.pushNode(nil)
+	}
+	l.insert(l.head, 0, v)
+}
+
+// Pop a value from the end of the list.
+//
+// Returns the value that was popped.
+func (l *Unrolled[T]) Pop() T {
+	if l.len == 0 {
+		panic("cannot Pop() from an empty list")
+	}
+	return l.remove(l.tail, len(l.tail.items)-1)
+}
+
+// Shift a value from the beginning of the list.
+//
+// Returns the value that was shifted.
+func (l *Unrolled[T]) Shift() T {
+	if l.len == 0 {
+		panic("cannot Shift() from an empty list")
+	}
+	return l.remove(l.head, 0)
+}
+
+// Returns the value at a given index.
+//
+// Returns false if the index is out of range.
+func (l *Unrolled[T]) Get(i int) (v T, ok bool) {
+	var n, offset = l.find(i)
+	if n == nil {
+		return
+	}
+	return n.items[offset], true
+}
+
+// Set the value at a given index.
+//
+// Returns false if the index is out of range.
+func (l *Unrolled[T]) Set(i int, v T) bool {
+	var n, offset = l.find(i)
+	if n == nil {
+		return false
+	}
+	n.items[offset] = v
+	return true
+}
+
+// Insert a value at a given index.
+//
+// Inserting at index Len() appends the value.
+//
+// Returns false if the index is out of range.
+func (l *Unrolled[T]) InsertAt(i int, v T) bool {
+	if i < 0 || i > l.len {
+		return false
+	}
+	if i == l.len {
+		l.Append(v)
+		return true
+	}
+	var n, offset = l.find(i)
+	l.insert(n, offset, v)
+	return true
+}
+
+// Remove a value from the list at a given index.
+func (l *Unrolled[T]) RemoveIndex(i int) bool {
+	var n, offset = l.find(i)
+	if n == nil {
+		return false
+	}
+	l.remove(n, offset)
+	return true
+}
+
+// Remove a value from the list if the predicate returns true.
+//
+// Returns the number of values removed.
+func (l *Unrolled[T]) RemoveIf(predicate func(T) bool) int {
+	var kept = make([]T, 0, l.len)
+	l.walk(func(v T) bool {
+		if !predicate(v) {
+			kept = append(kept, v)
+		}
+		return true
+	})
+	var removed = l.len - len(kept)
+	if removed > 0 {
+		l.Reset()
+		for _, v := range kept {
+			l.Append(v)
+		}
+	}
+	return removed
+}
+
+// Returns the list as a slice.
+func (l *Unrolled[T]) ToSlice() []T {
+	if l.len == 0 {
+		return nil
+	}
+	var slice = make([]T, 0, l.len)
+	for n := l.head; n != nil; n = n.next {
+		slice = append(slice, n.items...)
+	}
+	return slice
+}
+
+// Reset the list.
+func (l *Unrolled[T]) Reset() {
+	l.head = nil
+	l.tail = nil
+	l.len = 0
+}
+
+// Returns a sequence of the indices and values in the list, from head to tail.
+func (l *Unrolled[T]) All() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		var i = 0
+		for n := l.head; n != nil; n = n.next {
+			for _, v := range n.items {
+				if !yield(i, v) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Returns a sequence of the indices and values in the list, from tail to head.
+func (l *Unrolled[T]) Backward() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		var i = l.len - 1
+		for n := l.tail; n != nil; n = n.prev {
+			for j := len(n.items) - 1; j >= 0; j-- {
+				if !yield(i, n.items[j]) {
+					return
+				}
+				i--
+			}
+		}
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (l *Unrolled[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.ToSlice())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (l *Unrolled[T]) UnmarshalJSON(data []byte) error {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+	for _, v := range slice {
+		l.Append(v)
+	}
+	return nil
+}
+
+func (l *Unrolled[T]) walk(f func(T) bool) {
+	for n := l.head; n != nil; n = n.next {
+		for _, v := range n.items {
+			if !f(v) {
+				return
+			}
+		}
+	}
+}
+
+func (l *Unrolled[T]) size() int {
+	if l.nodeSize == 0 {
+		return defaultUnrolledNodeSize
+	}
+	return l.nodeSize
+}
+
+// Returns the node holding index i and the offset of i inside it.
+//
+// Walks from whichever end of the list is nearest.
+func (l *Unrolled[T]) find(i int) (*unrolledNode[T], int) {
+	if i < 0 || i >= l.len {
+		return nil, 0
+	}
+	if i < l.len/2 {
+		for n := l.head; n != nil; n = n.next {
+			if i < len(n.items) {
+				return n, i
+			}
+			i -= len(n.items)
+		}
+	} else {
+		i = l.len - 1 - i
+		for n := l.tail; n != nil; n = n.prev {
+			if i < len(n.items) {
+				return n, len(n.items) - 1 - i
+			}
+			i -= len(n.items)
+		}
+	}
+	return nil, 0
+}
+
+// Links a new, empty node after prev, or at the front if prev is nil.
+func (l *Unrolled[T]) pushNode(prev *unrolledNode[T]) *unrolledNode[T] {
+	var n = &unrolledNode[T]{
+		items: make([]T, 0, l.size()),
+		prev:  prev,
+	}
+	if prev == nil {
+		n.next = l.head
+		l.head = n
+	} else {
+		n.next = prev.next
+		prev.next = n
+	}
+	if n.next == nil {
+		l.tail = n
+	} else {
+		n.next.prev = n
+	}
+	return n
+}
+
+func (l *Unrolled[T]) unlinkNode(n *unrolledNode[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+}
+
+// Inserts v at the offset in node n, splitting n in half if it is full.
+func (l *Unrolled[T]) insert(n *unrolledNode[T], offset int, v T) {
+	if len(n.items) == cap(n.items) {
+		var half = len(n.items) / 2
+		var next = l.pushNode(n)
+		next.items = append(next.items, n.items[half:]...)
+		clearItems(n.items[half:])
+		n.items = n.items[:half]
+		if offset > half {
+			n, offset = next, offset-half
+		}
+	}
+
+	var zero T
+	n.items = append(n.items, zero)
+	copy(n.items[offset+1:], n.items[offset:])
+	n.items[offset] = v
+	l.len++
+}
+
+// Removes the value at the offset in node n,
+// then refills n from a neighbour if it dropped below half full.
+func (l *Unrolled[T]) remove(n *unrolledNode[T], offset int) T {
+	var v = n.items[offset]
+	copy(n.items[offset:], n.items[offset+1:])
+	clearItems(n.items[len(n.items)-1:])
+	n.items = n.items[:len(n.items)-1]
+	l.len--
+
+	var min = l.size() / 2
+	if len(n.items) >= min || (n.prev == nil && n.next == nil) {
+		if len(n.items) == 0 {
+			l.unlinkNode(n)
+		}
+		return v
+	}
+
+	// Prefer the next node, the previous one if n is the tail.
+	var neighbour, after = n.next, true
+	if neighbour == nil {
+		neighbour, after = n.prev, false
+	}
+
+	if len(neighbour.items) > min {
+		// borrow a single value
+		if after {
+			n.items = append(n.items, neighbour.items[0])
+			copy(neighbour.items, neighbour.items[1:])
+		} else {
+			var zero T
+			n.items = append(n.items, zero)
+			copy(n.items[1:], n.items)
+			n.items[0] = neighbour.items[len(neighbour.items)-1]
+		}
+		clearItems(neighbour.items[len(neighbour.items)-1:])
+		neighbour.items = neighbour.items[:len(neighbour.items)-1]
+		return v
+	}
+
+	// merge the later node into the earlier one
+	var first, second = n, neighbour
+	if !after {
+		first, second = neighbour, n
+	}
+	first.items = append(first.items, second.items...)
+	l.unlinkNode(second)
+	return v
+}
+
+func clearItems[T any](items []T) {
+	var zero T
+	for i := range items {
+		items[i] = zero
+	}
+}
@@ -0,0 +1,181 @@
+package linkedlist_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+func TestUnrolled(t *testing.T) {
+	var u = linkedlist.NewUnrolled[int](4)
+	for i := 0; i < 10; i++ {
+		u.Append(i)
+	}
+	u.Prepend(-1)
+	if u.String() != "[-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9]" || u.Len() != 11 {
+		t.Fatalf("Unexpected list: %s", u)
+	}
+
+	if v := u.Pop(); v != 9 {
+		t.Fatalf("Expected to pop 9, got %d", v)
+	}
+	if v := u.Shift(); v != -1 {
+		t.Fatalf("Expected to shift -1, got %d", v)
+	}
+	if !u.InsertAt(4, 40) || !u.RemoveIndex(0) || !u.Set(0, 10) {
+		t.Fatal("Unexpected positional result")
+	}
+	if u.String() != "[10, 2, 3, 40, 4, 5, 6, 7, 8]" {
+		t.Fatalf("Unexpected list: %s", u)
+	}
+	if v, ok := u.Get(3); !ok || v != 40 {
+		t.Fatalf("Expected 40 at index 3, got %d", v)
+	}
+	if _, ok := u.Get(9); ok || u.RemoveIndex(9) || u.InsertAt(10, 0) {
+		t.Fatal("Expected index 9 to be out of range")
+	}
+
+	if removed := u.RemoveIf(func(v int) bool { return v%2 == 0 }); removed != 6 {
+		t.Fatalf("Expected 6 values removed, got %d", removed)
+	}
+	if u.String() != "[3, 5, 7]" {
+		t.Fatalf("Unexpected list: %s", u)
+	}
+
+	var zero linkedlist.Unrolled[string]
+	zero.Append("a")
+	zero.Shift()
+	if zero.Len() != 0 || zero.ToSlice() != nil || zero.String() != "[]" {
+		t.Fatal("Expected an empty list")
+	}
+}
+
+// Compare against a plain slice under random operations.
+func TestUnrolledRandom(t *testing.T) {
+	var r = rand.New(rand.NewSource(1))
+	for _, size := range []int{2, 3, 4, 16} {
+		var u = linkedlist.NewUnrolled[int](size)
+		var want []int
+		for op := 0; op < 5000; op++ {
+			switch r.Intn(6) {
+			case 0:
+				u.Append(op)
+				want = append(want, op)
+			case 1:
+				u.Prepend(op)
+				want = append([]int{op}, want...)
+			case 2, 3:
+				var i = r.Intn(len(want) + 1)
+				u.InsertAt(i, op)
+				want = append(want[:i], append([]int{op}, want[i:]...)...)
+			case 4, 5:
+				if len(want) == 0 {
+					continue
+				}
+				var i = r.Intn(len(want))
+				u.RemoveIndex(i)
+				want = append(want[:i], want[i+1:]...)
+			}
+
+			if u.Len() != len(want) {
+				t.Fatalf("size %d, op %d: expected length %d, got %d", size, op, len(want), u.Len())
+			}
+		}
+
+		var got = u.ToSlice()
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("size %d: index %d: expected %d, got %d", size, i, want[i], got[i])
+			}
+			if v, _ := u.Get(i); v != want[i] {
+				t.Fatalf("size %d: Get(%d): expected %d, got %d", size, i, want[i], v)
+			}
+		}
+
+		var count = len(want)
+		u.Backward()(func(i, v int) bool {
+			count--
+			if i != count || v != want[i] {
+				t.Fatalf("size %d: Backward: expected %d: %d, got %d: %d", size, count, want[count], i, v)
+			}
+			return true
+		})
+
+		for len(want) > 0 {
+			if v := u.Shift(); v != want[0] {
+				t.Fatalf("size %d: expected to shift %d, got %d", size, want[0], v)
+			}
+			want = want[1:]
+		}
+	}
+}
+
+func TestUnrolledJSON(t *testing.T) {
+	var u = new(linkedlist.Unrolled[int])
+	for i := 0; i < 100; i++ {
+		u.Append(i)
+	}
+	var data, err = json.Marshal(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded = new(linkedlist.Unrolled[int])
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.String() != u.String() {
+		t.Fatalf("Expected %s after a JSON round-trip, got %s", u, decoded)
+	}
+
+	var mapped = linkedlist.Map[int, int](u, func(v int) int { return v * 2 })
+	if _, ok := mapped.(*linkedlist.Unrolled[int]); !ok {
+		t.Fatalf("Expected an *Unrolled[int], got %T", mapped)
+	}
+	if v, _ := mapped.Get(99); v != 198 {
+		t.Fatalf("Expected 198, got %d", v)
+	}
+}
+
+func BenchmarkUnrolledIterate(b *testing.B) {
+	var u = new(linkedlist.Unrolled[int])
+	for i := 0; i < 1<<12; i++ {
+		u.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum int
+		u.All()(func(_, v int) bool {
+			sum += v
+			return true
+		})
+	}
+}
+
+func BenchmarkDoublyIterate(b *testing.B) {
+	var d = new(linkedlist.Doubly[int])
+	for i := 0; i < 1<<12; i++ {
+		d.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sum int
+		d.All()(func(_, v int) bool {
+			sum += v
+			return true
+		})
+	}
+}
+
+func BenchmarkUnrolledInsertMiddle(b *testing.B) {
+	var u = new(linkedlist.Unrolled[int])
+	for i := 0; i < 1<<12; i++ {
+		u.Append(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u.InsertAt(u.Len()/2, i)
+		u.RemoveIndex(u.Len() / 2)
+	}
+}
@@ -0,0 +1,194 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/internal/signal"
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+// Returned when enqueueing onto a full Bounded queue.
+var ErrFull = errors.New("queue: queue is full")
+
+// The dequeueing side shared by Queue and Bounded.
+type core[T any] struct {
+	mu       sync.Mutex
+	list     linkedlist.Singly[T]
+	enqueued signal.Signal
+	dequeued signal.Signal
+}
+
+// Must be called with the lock held.
+func (q *core[T]) push(value T) {
+	q.list.Append(value)
+	q.enqueued.Notify()
+}
+
+// Must be called with the lock held, on a non-empty queue.
+func (q *core[T]) shift() T {
+	var v = q.list.Shift()
+	q.dequeued.Notify()
+	return v
+}
+
+// Shift a value if there is one, must be called with the lock held.
+func (q *core[T]) tryShift() (value T, ok bool) {
+	if q.list.Len() == 0 {
+		return
+	}
+	return q.shift(), true
+}
+
+// Dequeue removes a value from the front of the queue
+//
+// It panics if the queue is empty.
+func (q *core[T]) Dequeue() T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.list.Len() == 0 {
+		panic("cannot Dequeue() from an empty queue")
+	}
+	return q.shift()
+}
+
+// DequeueOK removes a value from the front of the queue
+//
+// It returns the value and a boolean indicating whether the value was removed
+func (q *core[T]) DequeueOK() (value T, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.tryShift()
+}
+
+// DequeueContext removes a value from the front of the queue,
+// waiting for one to be enqueued if the queue is empty.
+//
+// It returns the context's error if the context is done before a value is available.
+func (q *core[T]) DequeueContext(ctx context.Context) (value T, err error) {
+	return signal.WaitContext(ctx, &q.mu, &q.enqueued, q.tryShift)
+}
+
+// DequeueTimeout removes a value from the front of the queue,
+// waiting up to d for one to be enqueued if the queue is empty.
+//
+// It returns false if no value became available in time.
+func (q *core[T]) DequeueTimeout(d time.Duration) (value T, ok bool) {
+	return signal.WaitTimeout(d, &q.mu, &q.enqueued, q.tryShift)
+}
+
+// DequeueWaiter allows you to wait on a value through a channel.
+//
+// The value is sent as soon as it is enqueued.
+// The goroutine delivering the value only exits once a value is enqueued,
+// use DequeueContext to stop waiting early.
+func (q *core[T]) DequeueWaiter() <-chan T {
+	return signal.Waiter(&q.mu, &q.enqueued, q.tryShift)
+}
+
+// DequeueOKDeadline returns a channel where the value will be sent when it is available
+//
+// If the deadline passes first, the current time is sent on ok and ret is closed without a value.
+// The waiting goroutine exits either way.
+func (q *core[T]) DequeueOKDeadline(deadline time.Duration) (ret <-chan T, ok <-chan time.Time) {
+	return signal.WaitDeadline(deadline, &q.mu, &q.enqueued, q.tryShift)
+}
+
+// Peek returns the value at the front of the queue
+//
+// It returns the zero value if the queue is empty.
+func (q *core[T]) Peek() (value T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var head = q.list.Head()
+	if head == nil {
+		return
+	}
+	return head.Value()
+}
+
+// Len returns the number of values in the queue.
+func (q *core[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.list.Len()
+}
+
+// Queue is an unbounded FIFO queue.
+//
+// It is implemented using a singly linked list:
+// values are appended at the tail and shifted off the head.
+//
+// All methods are safe for concurrent use,
+// and goroutines can block until a value is enqueued with DequeueContext or DequeueTimeout.
+//
+// The zero value is an empty queue, ready to use.
+// A queue must not be copied after first use.
+type Queue[T any] struct {
+	core[T]
+}
+
+// Enqueue adds a value to the back of the queue
+//
+// If goroutines are waiting for a value, the one which has waited longest is woken up.
+func (q *Queue[T]) Enqueue(value T) {
+	q.mu.Lock()
+	q.push(value)
+	q.mu.Unlock()
+}
+
+// Bounded is a FIFO queue which holds at most a fixed number of values.
+//
+// It has the same methods as Queue, except that Enqueue fails when the queue is full,
+// and EnqueueContext waits for room.
+//
+// All methods are safe for concurrent use.
+type Bounded[T any] struct {
+	core[T]
+	capacity int
+}
+
+// Create a new bounded queue which holds at most capacity values.
+//
+// The capacity must be positive.
+func NewBounded[T any](capacity int) *Bounded[T] {
+	if capacity <= 0 {
+		panic("NewBounded[T] requires a positive capacity")
+	}
+	return &Bounded[T]{capacity: capacity}
+}
+
+// Enqueue adds a value to the back of the queue
+//
+// It returns ErrFull if the queue is full.
+func (q *Bounded[T]) Enqueue(value T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.list.Len() >= q.capacity {
+		return ErrFull
+	}
+	q.push(value)
+	return nil
+}
+
+// EnqueueContext adds a value to the back of the queue,
+// waiting for a value to be dequeued if the queue is full.
+//
+// It returns the context's error if the context is done before there is room.
+func (q *Bounded[T]) EnqueueContext(ctx context.Context, value T) error {
+	var _, err = signal.WaitContext(ctx, &q.mu, &q.dequeued, func() (struct{}, bool) {
+		if q.list.Len() >= q.capacity {
+			return struct{}{}, false
+		}
+		q.push(value)
+		return struct{}{}, true
+	})
+	return err
+}
+
+// Cap returns the maximum number of values the queue holds.
+func (q *Bounded[T]) Cap() int {
+	return q.capacity
+}
@@ -0,0 +1,184 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/queue"
+)
+
+func TestQueue(t *testing.T) {
+	var q queue.Queue[int]
+	for i := 1; i <= 5; i++ {
+		q.Enqueue(i)
+	}
+	if q.Len() != 5 || q.Peek() != 1 {
+		t.Fatalf("Expected 5 values with 1 at the front, got %d with %d", q.Len(), q.Peek())
+	}
+	for i := 1; i <= 4; i++ {
+		if v := q.Dequeue(); v != i {
+			t.Fatalf("Expected %d, got %d", i, v)
+		}
+	}
+	if v, ok := q.DequeueOK(); !ok || v != 5 {
+		t.Fatalf("Expected 5, got %d", v)
+	}
+	if _, ok := q.DequeueOK(); ok || q.Peek() != 0 {
+		t.Fatal("Expected an empty queue")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected Dequeue to panic on an empty queue")
+		}
+	}()
+	q.Dequeue()
+}
+
+func TestQueueWaiting(t *testing.T) {
+	var q queue.Queue[int]
+	var ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, err := q.DequeueContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if _, ok := q.DequeueTimeout(time.Millisecond); ok {
+		t.Fatal("Expected DequeueTimeout to time out")
+	}
+
+	const waiters = 20
+	var (
+		wg  sync.WaitGroup
+		sum = make(chan int, waiters)
+	)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var v, err = q.DequeueContext(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+			sum <- v
+		}()
+	}
+	for i := 1; i <= waiters; i++ {
+		q.Enqueue(i)
+	}
+	wg.Wait()
+	close(sum)
+	var total int
+	for v := range sum {
+		total += v
+	}
+	if total != waiters*(waiters+1)/2 {
+		t.Fatalf("Expected a total of %d, got %d", waiters*(waiters+1)/2, total)
+	}
+}
+
+func TestQueueWaiterChannels(t *testing.T) {
+	var q queue.Queue[int]
+	var before = runtime.NumGoroutine()
+
+	var ret, expired = q.DequeueOKDeadline(time.Millisecond)
+	<-expired
+	if _, ok := <-ret; ok {
+		t.Fatal("Expected no value after the deadline")
+	}
+
+	var c = q.DequeueWaiter()
+	ret, _ = q.DequeueOKDeadline(time.Second)
+	q.Enqueue(1)
+	q.Enqueue(2)
+	if a, b := <-c, <-ret; a+b != 3 {
+		t.Fatalf("Expected 1 and 2, got %d and %d", a, b)
+	}
+
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		t.Fatalf("Expected %d goroutines, got %d", before, n)
+	}
+}
+
+func TestBounded(t *testing.T) {
+	var q = queue.NewBounded[int](2)
+	if q.Enqueue(1) != nil || q.Enqueue(2) != nil {
+		t.Fatal("Expected room for 2 values")
+	}
+	if err := q.Enqueue(3); !errors.Is(err, queue.ErrFull) {
+		t.Fatalf("Expected %v, got %v", queue.ErrFull, err)
+	}
+
+	var ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if err := q.EnqueueContext(ctx, 3); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	var done = make(chan error)
+	go func() {
+		done <- q.EnqueueContext(context.Background(), 3)
+	}()
+	time.Sleep(time.Millisecond)
+	if v := q.Dequeue(); v != 1 {
+		t.Fatalf("Expected 1, got %d", v)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if q.Len() != 2 || q.Cap() != 2 || q.Dequeue() != 2 || q.Dequeue() != 3 {
+		t.Fatal("Expected [2 3]")
+	}
+}
+
+// Producers and consumers on a small bounded queue must neither lose nor duplicate values.
+func TestBoundedConcurrent(t *testing.T) {
+	const producers, perProducer = 4, 1000
+	var (
+		q        = queue.NewBounded[int](8)
+		wg       sync.WaitGroup
+		received = make(chan int, producers*perProducer)
+	)
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := q.EnqueueContext(context.Background(), p*perProducer+i); err != nil {
+					t.Error(err)
+				}
+			}
+		}(p)
+	}
+	for c := 0; c < producers; c++ {
+		go func() {
+			for {
+				var v, ok = q.DequeueTimeout(100 * time.Millisecond)
+				if !ok {
+					return
+				}
+				received <- v
+			}
+		}()
+	}
+	wg.Wait()
+
+	var seen = make(map[int]bool)
+	for len(seen) < producers*perProducer {
+		select {
+		case v := <-received:
+			if seen[v] {
+				t.Fatalf("%d dequeued twice", v)
+			}
+			seen[v] = true
+		case <-time.After(time.Second):
+			t.Fatalf("Expected %d values, got %d", producers*perProducer, len(seen))
+		}
+	}
+}
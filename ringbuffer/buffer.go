@@ -0,0 +1,136 @@
+package ringbuffer
+
+import (
+	"fmt"
+	"io"
+)
+
+var _ io.ReadWriter = (*Buffer)(nil)
+
+// A byte ring buffer with a fixed capacity, which implements io.ReadWriter.
+//
+// Writes append at the back and reads consume from the front.
+// In Overwrite mode a write which does not fit discards the oldest bytes,
+// so the buffer always holds the most recent bytes written, like a log tail.
+// In Reject mode a write only stores the bytes which fit, and returns ErrFull.
+//
+// A buffer is not safe for concurrent use.
+type Buffer struct {
+	buf  []byte
+	head int
+	len  int
+	mode Mode
+}
+
+// Create a new byte ring buffer which holds at most size bytes.
+//
+// The size must be positive.
+func NewBuffer(size int, mode Mode) *Buffer {
+	if size <= 0 {
+		panic(fmt.Sprintf("NewBuffer requires a positive size, %d given", size))
+	}
+	return &Buffer{
+		buf:  make([]byte, size),
+		mode: mode,
+	}
+}
+
+// Returns the number of unread bytes in the buffer.
+func (b *Buffer) Len() int {
+	return b.len
+}
+
+// Returns the maximum number of bytes the buffer holds.
+func (b *Buffer) Cap() int {
+	return len(b.buf)
+}
+
+// Write appends the contents of p to the buffer.
+//
+// In Overwrite mode it always writes all of p, discarding the oldest bytes if needed.
+// In Reject mode it writes as much of p as fits, and returns ErrFull if that is not all of it.
+func (b *Buffer) Write(p []byte) (n int, err error) {
+	var free = len(b.buf) - b.len
+	if len(p) <= free {
+		b.put(p)
+		return len(p), nil
+	}
+
+	if b.mode == Reject {
+		b.put(p[:free])
+		return free, ErrFull
+	}
+
+	if len(p) >= len(b.buf) {
+		// Only the end of p fits.
+		copy(b.buf, p[len(p)-len(b.buf):])
+		b.head, b.len = 0, len(b.buf)
+		return len(p), nil
+	}
+
+	b.discard(len(p) - free)
+	b.put(p)
+	return len(p), nil
+}
+
+// Read reads up to len(p) bytes from the front of the buffer.
+//
+// It returns io.EOF if the buffer is empty and p is not.
+func (b *Buffer) Read(p []byte) (n int, err error) {
+	if b.len == 0 {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		return 0, io.EOF
+	}
+	n = b.peek(p)
+	b.discard(n)
+	return n, nil
+}
+
+// Returns a copy of the unread bytes, without consuming them.
+func (b *Buffer) Bytes() []byte {
+	var p = make([]byte, b.len)
+	b.peek(p)
+	return p
+}
+
+// Discard all unread bytes.
+func (b *Buffer) Reset() {
+	b.head = 0
+	b.len = 0
+}
+
+// Copies up to len(p) unread bytes into p, returns the number of bytes copied.
+func (b *Buffer) peek(p []byte) int {
+	var n = min(len(p), b.len)
+	var first = copy(p[:n], b.buf[b.head:min(b.head+n, len(b.buf))])
+	copy(p[first:n], b.buf[:n-first])
+	return n
+}
+
+// Stores p after the unread bytes, p must fit in the free space.
+func (b *Buffer) put(p []byte) {
+	var tail = b.head + b.len
+	if tail >= len(b.buf) {
+		tail -= len(b.buf)
+		copy(b.buf[tail:b.head], p)
+	} else {
+		var n = copy(b.buf[tail:], p)
+		copy(b.buf, p[n:])
+	}
+	b.len += len(p)
+}
+
+// Drops the n oldest bytes.
+func (b *Buffer) discard(n int) {
+	b.len -= n
+	if b.len == 0 {
+		b.head = 0
+		return
+	}
+	b.head += n
+	if b.head >= len(b.buf) {
+		b.head -= len(b.buf)
+	}
+}
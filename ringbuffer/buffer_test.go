@@ -0,0 +1,82 @@
+package ringbuffer_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/ringbuffer"
+)
+
+func TestBufferOverwrite(t *testing.T) {
+	var b = ringbuffer.NewBuffer(8, ringbuffer.Overwrite)
+	io.WriteString(b, "hello")
+	io.WriteString(b, " world")
+	if string(b.Bytes()) != "lo world" {
+		t.Fatalf("Expected the last 8 bytes, got %q", b.Bytes())
+	}
+
+	var p = make([]byte, 3)
+	if n, err := b.Read(p); n != 3 || err != nil || string(p) != "lo " {
+		t.Fatalf("Expected to read %q, got %q (%v)", "lo ", p[:n], err)
+	}
+	io.WriteString(b, "!!")
+	if string(b.Bytes()) != "world!!" || b.Len() != 7 {
+		t.Fatalf("Expected %q, got %q", "world!!", b.Bytes())
+	}
+
+	if n, err := io.WriteString(b, "a much longer line"); n != 18 || err != nil {
+		t.Fatalf("Expected the whole line to be written, got %d (%v)", n, err)
+	}
+	var all, err = io.ReadAll(b)
+	if err != nil || string(all) != "ger line" {
+		t.Fatalf("Expected %q, got %q (%v)", "ger line", all, err)
+	}
+	if _, err := b.Read(p); err != io.EOF {
+		t.Fatalf("Expected io.EOF, got %v", err)
+	}
+}
+
+func TestBufferReject(t *testing.T) {
+	var b = ringbuffer.NewBuffer(4, ringbuffer.Reject)
+	if n, err := b.Write([]byte("abcdef")); n != 4 || !errors.Is(err, ringbuffer.ErrFull) {
+		t.Fatalf("Expected 4 bytes and %v, got %d (%v)", ringbuffer.ErrFull, n, err)
+	}
+	var p = make([]byte, 2)
+	b.Read(p)
+	if n, err := b.Write([]byte("ef")); n != 2 || err != nil {
+		t.Fatalf("Expected room for 2 bytes, got %d (%v)", n, err)
+	}
+	if string(b.Bytes()) != "cdef" {
+		t.Fatalf("Expected %q, got %q", "cdef", b.Bytes())
+	}
+}
+
+// Data copied through a small buffer must arrive intact.
+func TestBufferCopy(t *testing.T) {
+	var (
+		b   = ringbuffer.NewBuffer(7, ringbuffer.Reject)
+		src = strings.Repeat("0123456789", 100)
+		dst strings.Builder
+		p   = make([]byte, 5)
+	)
+	for i := 0; i < len(src) || b.Len() > 0; {
+		if i < len(src) {
+			var n, _ = b.Write([]byte(src[i:min(i+3, len(src))]))
+			i += n
+		}
+		var n, _ = b.Read(p)
+		dst.Write(p[:n])
+	}
+	if dst.String() != src {
+		t.Fatal("Expected the data to be copied intact")
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,6 @@
+package ringbuffer
+
+import "errors"
+
+// Returned by a Buffer in Reject mode when not all bytes fit.
+var ErrFull = errors.New("ringbuffer: buffer is full")
@@ -0,0 +1,167 @@
+package ringbuffer
+
+import (
+	"fmt"
+)
+
+// What a ring buffer does when a value is pushed while it is full.
+type Mode int
+
+const (
+	// Overwrite the oldest value.
+	Overwrite Mode = iota
+	// Reject the new value.
+	Reject
+)
+
+func (m Mode) String() string {
+	switch m {
+	case Overwrite:
+		return "Overwrite"
+	case Reject:
+		return "Reject"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// A ring buffer with a fixed capacity.
+//
+// Values are pushed at the back and popped from the front, oldest first.
+// When the ring is full, the mode decides whether the oldest value is overwritten
+// or the new value is rejected.
+//
+// A ring is not safe for concurrent use, see SPSC for a lock-free variant.
+//
+// Create a ring with New, the zero value has no capacity and rejects every value.
+type Ring[T any] struct {
+	buf  []T
+	head int
+	len  int
+	mode Mode
+}
+
+// Create a new ring buffer which holds at most capacity values.
+//
+// The capacity must be positive.
+func New[T any](capacity int, mode Mode) *Ring[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("New[T] requires a positive capacity, %d given", capacity))
+	}
+	return &Ring[T]{
+		buf:  make([]T, capacity),
+		mode: mode,
+	}
+}
+
+// Returns the number of values in the ring.
+func (r *Ring[T]) Len() int {
+	return r.len
+}
+
+// Returns the maximum number of values the ring holds.
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Report whether the ring is full.
+func (r *Ring[T]) Full() bool {
+	return r.len == len(r.buf)
+}
+
+// Push a value to the back of the ring.
+//
+// If the ring is full, the oldest value is overwritten in Overwrite mode,
+// and the value is dropped in Reject mode.
+//
+// Returns false if the value was rejected.
+func (r *Ring[T]) Push(v T) bool {
+	if r.len == len(r.buf) {
+		if r.mode == Reject || len(r.buf) == 0 {
+			return false
+		}
+		r.buf[r.head] = v
+		r.head = r.index(1)
+		return true
+	}
+	r.buf[r.index(r.len)] = v
+	r.len++
+	return true
+}
+
+// Pop the oldest value from the front of the ring.
+//
+// Returns false if the ring is empty.
+func (r *Ring[T]) Pop() (v T, ok bool) {
+	if r.len == 0 {
+		return
+	}
+	var zero T
+	v, r.buf[r.head] = r.buf[r.head], zero
+	r.head = r.index(1)
+	r.len--
+	return v, true
+}
+
+// Returns the oldest value in the ring.
+//
+// Returns false if the ring is empty.
+func (r *Ring[T]) Peek() (v T, ok bool) {
+	if r.len == 0 {
+		return
+	}
+	return r.buf[r.head], true
+}
+
+// Returns the value at a given index, where index 0 is the oldest value.
+//
+// Returns false if the index is out of range.
+func (r *Ring[T]) At(i int) (v T, ok bool) {
+	if i < 0 || i >= r.len {
+		return
+	}
+	return r.buf[r.index(i)], true
+}
+
+// Call f for every value in the ring, from oldest to newest.
+func (r *Ring[T]) Do(f func(T)) {
+	for i := 0; i < r.len; i++ {
+		f(r.buf[r.index(i)])
+	}
+}
+
+// Returns a copy of the values in the ring, from oldest to newest.
+func (r *Ring[T]) ToSlice() []T {
+	if r.len == 0 {
+		return nil
+	}
+	var slice = make([]T, r.len)
+	var n = copy(slice, r.buf[r.head:min(r.head+r.len, len(r.buf))])
+	copy(slice[n:], r.buf[:r.len-n])
+	return slice
+}
+
+// Remove all values from the ring.
+func (r *Ring[T]) Reset() {
+	var zero T
+	for i := 0; i < r.len; i++ {
+		r.buf[r.index(i)] = zero
+	}
+	r.head = 0
+	r.len = 0
+}
+
+// Returns the buffer position of index i.
+func (r *Ring[T]) index(i int) int {
+	i += r.head
+	if i >= len(r.buf) {
+		i -= len(r.buf)
+	}
+	return i
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
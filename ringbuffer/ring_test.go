@@ -0,0 +1,76 @@
+package ringbuffer_test
+
+import (
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/ringbuffer"
+)
+
+func TestRingOverwrite(t *testing.T) {
+	var r = ringbuffer.New[int](3, ringbuffer.Overwrite)
+	for i := 1; i <= 5; i++ {
+		if !r.Push(i) {
+			t.Fatalf("Expected %d to be pushed", i)
+		}
+	}
+	if r.Len() != 3 || r.Cap() != 3 || !r.Full() {
+		t.Fatalf("Expected a full ring of 3, got %d", r.Len())
+	}
+	if s := r.ToSlice(); len(s) != 3 || s[0] != 3 || s[2] != 5 {
+		t.Fatalf("Expected [3 4 5], got %v", s)
+	}
+	if v, _ := r.At(1); v != 4 {
+		t.Fatalf("Expected 4 at index 1, got %d", v)
+	}
+	if _, ok := r.At(3); ok {
+		t.Fatal("Expected index 3 to be out of range")
+	}
+
+	var sum int
+	r.Do(func(v int) { sum += v })
+	if sum != 12 {
+		t.Fatalf("Expected a sum of 12, got %d", sum)
+	}
+
+	if v, _ := r.Peek(); v != 3 {
+		t.Fatalf("Expected 3 at the front, got %d", v)
+	}
+	if v, ok := r.Pop(); !ok || v != 3 {
+		t.Fatalf("Expected to pop 3, got %d", v)
+	}
+	r.Push(6)
+	if s := r.ToSlice(); len(s) != 3 || s[0] != 4 || s[2] != 6 {
+		t.Fatalf("Expected [4 5 6], got %v", s)
+	}
+
+	r.Reset()
+	if _, ok := r.Pop(); ok || r.ToSlice() != nil {
+		t.Fatal("Expected an empty ring")
+	}
+}
+
+func TestRingReject(t *testing.T) {
+	var r = ringbuffer.New[string](2, ringbuffer.Reject)
+	if !r.Push("a") || !r.Push("b") || r.Push("c") {
+		t.Fatal("Expected the third value to be rejected")
+	}
+	if v, _ := r.Pop(); v != "a" {
+		t.Fatalf("Expected a, got %q", v)
+	}
+	if !r.Push("c") {
+		t.Fatal("Expected room for c")
+	}
+	if s := r.ToSlice(); len(s) != 2 || s[0] != "b" || s[1] != "c" {
+		t.Fatalf("Expected [b c], got %v", s)
+	}
+}
+
+func TestRingZeroValue(t *testing.T) {
+	var r ringbuffer.Ring[int]
+	if r.Push(1) || r.Len() != 0 || r.Cap() != 0 {
+		t.Fatal("Expected the zero value to reject every value")
+	}
+	if _, ok := r.Pop(); ok {
+		t.Fatal("Expected nothing to pop")
+	}
+}
@@ -0,0 +1,101 @@
+package ringbuffer
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// A lock-free ring buffer for exactly one producer and one consumer goroutine.
+//
+// The producer may only call Push, the consumer may only call Pop and Peek;
+// Len and Cap are safe to call from either.
+// Only the producer writes the tail and only the consumer writes the head,
+// so neither side ever waits for the other.
+//
+// Because the producer cannot safely overwrite a value the consumer may be reading,
+// Push always rejects values when the buffer is full.
+type SPSC[T any] struct {
+	buf  []T
+	mask uint64
+
+	// The head and tail are kept on separate cache lines,
+	// so the producer and consumer do not slow each other down.
+	_    [56]byte
+	head atomic.Uint64
+	_    [56]byte
+	tail atomic.Uint64
+	_    [56]byte
+}
+
+// Create a new single-producer single-consumer ring buffer.
+//
+// The capacity is rounded up to a power of two, and must be positive.
+func NewSPSC[T any](capacity int) *SPSC[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("NewSPSC[T] requires a positive capacity, %d given", capacity))
+	}
+	var size = 1
+	for size < capacity {
+		size <<= 1
+	}
+	return &SPSC[T]{
+		buf:  make([]T, size),
+		mask: uint64(size - 1),
+	}
+}
+
+// Push a value to the back of the buffer.
+//
+// Returns false if the buffer is full.
+// It must only be called by the producer.
+func (r *SPSC[T]) Push(v T) bool {
+	var tail = r.tail.Load()
+	if tail-r.head.Load() == uint64(len(r.buf)) {
+		return false
+	}
+	r.buf[tail&r.mask] = v
+	// Publish the value to the consumer.
+	r.tail.Store(tail + 1)
+	return true
+}
+
+// Pop the oldest value from the front of the buffer.
+//
+// Returns false if the buffer is empty.
+// It must only be called by the consumer.
+func (r *SPSC[T]) Pop() (v T, ok bool) {
+	var head = r.head.Load()
+	if head == r.tail.Load() {
+		return
+	}
+	var zero T
+	v, r.buf[head&r.mask] = r.buf[head&r.mask], zero
+	// Hand the slot back to the producer.
+	r.head.Store(head + 1)
+	return v, true
+}
+
+// Returns the oldest value in the buffer.
+//
+// Returns false if the buffer is empty.
+// It must only be called by the consumer.
+func (r *SPSC[T]) Peek() (v T, ok bool) {
+	var head = r.head.Load()
+	if head == r.tail.Load() {
+		return
+	}
+	return r.buf[head&r.mask], true
+}
+
+// Returns the number of values in the buffer.
+//
+// The length is approximate while the producer or consumer is running.
+func (r *SPSC[T]) Len() int {
+	var head = r.head.Load()
+	return int(r.tail.Load() - head)
+}
+
+// Returns the maximum number of values the buffer holds.
+func (r *SPSC[T]) Cap() int {
+	return len(r.buf)
+}
@@ -0,0 +1,82 @@
+package ringbuffer_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/ringbuffer"
+)
+
+func TestSPSC(t *testing.T) {
+	var r = ringbuffer.NewSPSC[int](3)
+	if r.Cap() != 4 {
+		t.Fatalf("Expected capacity 4, got %d", r.Cap())
+	}
+	for i := 0; i < 4; i++ {
+		if !r.Push(i) {
+			t.Fatalf("Expected %d to be pushed", i)
+		}
+	}
+	if r.Push(4) || r.Len() != 4 {
+		t.Fatal("Expected a full buffer")
+	}
+	if v, _ := r.Peek(); v != 0 {
+		t.Fatalf("Expected 0 at the front, got %d", v)
+	}
+	for i := 0; i < 4; i++ {
+		if v, ok := r.Pop(); !ok || v != i {
+			t.Fatalf("Expected %d, got %d", i, v)
+		}
+	}
+	if _, ok := r.Pop(); ok {
+		t.Fatal("Expected an empty buffer")
+	}
+}
+
+func TestSPSCConcurrent(t *testing.T) {
+	const values = 100000
+	var r = ringbuffer.NewSPSC[int](64)
+	go func() {
+		for i := 0; i < values; {
+			if r.Push(i) {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+	}()
+	for i := 0; i < values; {
+		var v, ok = r.Pop()
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		if v != i {
+			t.Fatalf("Expected %d, got %d", i, v)
+		}
+		i++
+	}
+}
+
+func BenchmarkSPSC(b *testing.B) {
+	var r = ringbuffer.NewSPSC[int](1024)
+	var done = make(chan struct{})
+	go func() {
+		for i := 0; i < b.N; {
+			if _, ok := r.Pop(); ok {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+		close(done)
+	}()
+	for i := 0; i < b.N; {
+		if r.Push(i) {
+			i++
+		} else {
+			runtime.Gosched()
+		}
+	}
+	<-done
+}
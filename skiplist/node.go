@@ -0,0 +1,45 @@
+package skiplist
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// A node in the skip list.
+//
+// A node is only part of the set once it is fully linked on every level,
+// and stops being part of it as soon as it is marked for deletion.
+type node[K any, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[node[K, V]]
+
+	// Locked while the node is the predecessor of a node being linked or unlinked,
+	// and while the node itself is being deleted or updated.
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+func newNode[K any, V any](key K, value V, level int) *node[K, V] {
+	var n = &node[K, V]{
+		key:  key,
+		next: make([]atomic.Pointer[node[K, V]], level),
+	}
+	n.value.Store(&value)
+	return n
+}
+
+// Returns the number of levels the node is linked on.
+func (n *node[K, V]) level() int {
+	return len(n.next)
+}
+
+// Report whether the node is part of the set.
+func (n *node[K, V]) live() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+func (n *node[K, V]) load() V {
+	return *n.value.Load()
+}
@@ -0,0 +1,366 @@
+package skiplist
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Nigel2392/go-datastructures"
+)
+
+const (
+	// The maximum number of levels a node can be linked on.
+	//
+	// With a branching factor of 4, this comfortably covers 4^24 keys.
+	maxLevel = 24
+)
+
+// A concurrent sorted map, implemented as a lazy skip list.
+//
+// Get, Floor, Ceiling and iteration are wait-free and never take a lock.
+// Insert and Delete only lock the handful of nodes around the key they change,
+// so operations on different parts of the list do not block each other.
+//
+// Iteration is weakly consistent: it sees every key which is present for the whole iteration,
+// and may or may not see keys which are inserted or deleted while it runs.
+//
+// All methods are safe for concurrent use.
+type SkipList[K any, V any] struct {
+	head *node[K, V]
+	cmp  func(a, b K) int
+	len  atomic.Int64
+	// The highest level any node has been linked on, searches start there.
+	top atomic.Int32
+
+	randMu sync.Mutex
+	rand   *rand.Rand
+}
+
+type config struct {
+	source rand.Source
+}
+
+// An option for creating a skip list.
+type Option func(*config)
+
+// Use the given source of randomness to pick the level of new nodes.
+//
+// A source with a fixed seed makes the shape of the list deterministic,
+// which is useful for reproducible tests and benchmarks.
+// The source does not need to be safe for concurrent use.
+func WithRandSource(source rand.Source) Option {
+	return func(c *config) {
+		c.source = source
+	}
+}
+
+// Create a new skip list for an ordered key type.
+func New[K datastructures.Ordered, V any](opts ...Option) *SkipList[K, V] {
+	return NewFunc[K, V](func(a, b K) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}, opts...)
+}
+
+// Create a new skip list which orders keys with the comparison function.
+//
+// The function must return a negative number if a < b, a positive number if a > b,
+// and zero if they are equal.
+func NewFunc[K any, V any](cmp func(a, b K) int, opts ...Option) *SkipList[K, V] {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	var l = &SkipList[K, V]{
+		head: &node[K, V]{
+			next: make([]atomic.Pointer[node[K, V]], maxLevel),
+		},
+		cmp: cmp,
+	}
+	l.head.fullyLinked.Store(true)
+	if c.source != nil {
+		l.rand = rand.New(c.source)
+	}
+	return l
+}
+
+// Returns the number of keys in the list.
+func (l *SkipList[K, V]) Len() int {
+	return int(l.len.Load())
+}
+
+// Insert a key with a value.
+//
+// If the key is already present its value is replaced.
+//
+// Returns true if the key was not present.
+func (l *SkipList[K, V]) Insert(key K, value V) (inserted bool) {
+	var (
+		level        = l.randomLevel()
+		preds, succs [maxLevel]*node[K, V]
+	)
+	for {
+		var found = l.find(key, &preds, &succs)
+		if found != -1 {
+			var n = succs[found]
+			if n.marked.Load() {
+				// It is being deleted, wait for it to be unlinked.
+				runtime.Gosched()
+				continue
+			}
+			for !n.fullyLinked.Load() {
+				runtime.Gosched()
+			}
+			n.mu.Lock()
+			if n.marked.Load() {
+				n.mu.Unlock()
+				continue
+			}
+			n.value.Store(&value)
+			n.mu.Unlock()
+			return false
+		}
+
+		var highest, valid = -1, true
+		for i := 0; valid && i < level; i++ {
+			var pred, succ = preds[i], succs[i]
+			if i == 0 || pred != preds[i-1] {
+				pred.mu.Lock()
+			}
+			highest = i
+			valid = !pred.marked.Load() &&
+				(succ == nil || !succ.marked.Load()) &&
+				pred.next[i].Load() == succ
+		}
+		if !valid {
+			unlock(&preds, highest)
+			continue
+		}
+
+		var n = newNode(key, value, level)
+		for top := l.top.Load(); int(top) < level; top = l.top.Load() {
+			if l.top.CompareAndSwap(top, int32(level)) {
+				break
+			}
+		}
+		for i := 0; i < level; i++ {
+			n.next[i].Store(succs[i])
+		}
+		for i := 0; i < level; i++ {
+			preds[i].next[i].Store(n)
+		}
+		n.fullyLinked.Store(true)
+		unlock(&preds, highest)
+		l.len.Add(1)
+		return true
+	}
+}
+
+// Delete a key from the list.
+//
+// Returns true if the key was present.
+func (l *SkipList[K, V]) Delete(key K) (deleted bool) {
+	var (
+		victim       *node[K, V]
+		preds, succs [maxLevel]*node[K, V]
+	)
+	for {
+		var found = l.find(key, &preds, &succs)
+		if victim == nil {
+			if found == -1 {
+				return false
+			}
+			var n = succs[found]
+			// Only delete a node which is fully linked, found at its top level and not yet marked.
+			if !n.fullyLinked.Load() || n.level()-1 != found || n.marked.Load() {
+				return false
+			}
+			n.mu.Lock()
+			if n.marked.Load() {
+				n.mu.Unlock()
+				return false
+			}
+			n.marked.Store(true)
+			victim = n
+		}
+
+		var level = victim.level()
+		var highest, valid = -1, true
+		for i := 0; valid && i < level; i++ {
+			var pred = preds[i]
+			if i == 0 || pred != preds[i-1] {
+				pred.mu.Lock()
+			}
+			highest = i
+			valid = !pred.marked.Load() && pred.next[i].Load() == victim
+		}
+		if !valid {
+			unlock(&preds, highest)
+			continue
+		}
+
+		for i := level - 1; i >= 0; i-- {
+			preds[i].next[i].Store(victim.next[i].Load())
+		}
+		victim.mu.Unlock()
+		unlock(&preds, highest)
+		l.len.Add(-1)
+		return true
+	}
+}
+
+// Returns the value for a key.
+//
+// Returns false if the key is not present.
+func (l *SkipList[K, V]) Get(key K) (v V, ok bool) {
+	var n = l.ceiling(key)
+	if n == nil || l.cmp(n.key, key) != 0 || !n.live() {
+		return
+	}
+	return n.load(), true
+}
+
+// Report whether the key is present.
+func (l *SkipList[K, V]) Contains(key K) bool {
+	var _, ok = l.Get(key)
+	return ok
+}
+
+// Returns the greatest key less than or equal to the given key, and its value.
+//
+// Returns false if there is no such key.
+func (l *SkipList[K, V]) Floor(key K) (k K, v V, ok bool) {
+	var n = l.floor(key, true)
+	for n != nil && !n.live() {
+		n = l.floor(n.key, false)
+	}
+	if n == nil {
+		return
+	}
+	return n.key, n.load(), true
+}
+
+// Returns the least key greater than or equal to the given key, and its value.
+//
+// Returns false if there is no such key.
+func (l *SkipList[K, V]) Ceiling(key K) (k K, v V, ok bool) {
+	var n = l.ceiling(key)
+	for n != nil && !n.live() {
+		n = n.next[0].Load()
+	}
+	if n == nil {
+		return
+	}
+	return n.key, n.load(), true
+}
+
+// Call f for every key in [from, to), in ascending order.
+//
+// Iteration stops when f returns false.
+func (l *SkipList[K, V]) Range(from, to K, f func(K, V) bool) {
+	for n := l.ceiling(from); n != nil && l.cmp(n.key, to) < 0; n = n.next[0].Load() {
+		if n.live() && !f(n.key, n.load()) {
+			return
+		}
+	}
+}
+
+// Call f for every key in the list, in ascending order.
+//
+// Iteration stops when f returns false.
+func (l *SkipList[K, V]) Each(f func(K, V) bool) {
+	for n := l.head.next[0].Load(); n != nil; n = n.next[0].Load() {
+		if n.live() && !f(n.key, n.load()) {
+			return
+		}
+	}
+}
+
+// Fill preds and succs with the nodes before and at or after the key on every level.
+//
+// Returns the highest level the key was found on, or -1 if it was not found.
+func (l *SkipList[K, V]) find(key K, preds, succs *[maxLevel]*node[K, V]) int {
+	var found, top = -1, int(l.top.Load())
+	for i := maxLevel - 1; i >= top; i-- {
+		preds[i], succs[i] = l.head, nil
+	}
+	var pred = l.head
+	for i := top - 1; i >= 0; i-- {
+		var curr = pred.next[i].Load()
+		for curr != nil && l.cmp(curr.key, key) < 0 {
+			pred, curr = curr, curr.next[i].Load()
+		}
+		if found == -1 && curr != nil && l.cmp(curr.key, key) == 0 {
+			found = i
+		}
+		preds[i], succs[i] = pred, curr
+	}
+	return found
+}
+
+// Returns the first node with a key greater than or equal to the key, live or not.
+func (l *SkipList[K, V]) ceiling(key K) *node[K, V] {
+	var pred = l.head
+	var curr = pred.next[0].Load()
+	for i := int(l.top.Load()) - 1; i >= 0; i-- {
+		curr = pred.next[i].Load()
+		for curr != nil && l.cmp(curr.key, key) < 0 {
+			pred, curr = curr, curr.next[i].Load()
+		}
+	}
+	return curr
+}
+
+// Returns the last node with a key less than (or equal to, if inclusive) the key, live or not.
+//
+// Returns nil if there is no such node.
+func (l *SkipList[K, V]) floor(key K, inclusive bool) *node[K, V] {
+	var pred = l.head
+	for i := int(l.top.Load()) - 1; i >= 0; i-- {
+		for curr := pred.next[i].Load(); curr != nil; curr = curr.next[i].Load() {
+			var c = l.cmp(curr.key, key)
+			if c > 0 || c == 0 && !inclusive {
+				break
+			}
+			pred = curr
+		}
+	}
+	if pred == l.head {
+		return nil
+	}
+	return pred
+}
+
+// Returns a random level between 1 and maxLevel,
+// where every level is a quarter as likely as the one below it.
+func (l *SkipList[K, V]) randomLevel() int {
+	var r uint64
+	if l.rand == nil {
+		r = rand.Uint64()
+	} else {
+		l.randMu.Lock()
+		r = l.rand.Uint64()
+		l.randMu.Unlock()
+	}
+	var level = 1
+	for level < maxLevel && r&3 == 0 {
+		level++
+		r >>= 2
+	}
+	return level
+}
+
+// Unlock the distinct predecessors locked on levels 0 through highest.
+func unlock[K any, V any](preds *[maxLevel]*node[K, V], highest int) {
+	for i := 0; i <= highest; i++ {
+		if i == 0 || preds[i] != preds[i-1] {
+			preds[i].mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package skiplist_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/binarytree"
+	"github.com/Nigel2392/go-datastructures/skiplist"
+)
+
+func keys[K any, V any](l *skiplist.SkipList[K, V]) []K {
+	var ks []K
+	l.Each(func(k K, _ V) bool {
+		ks = append(ks, k)
+		return true
+	})
+	return ks
+}
+
+func TestSkipList(t *testing.T) {
+	var l = skiplist.New[int, string](skiplist.WithRandSource(rand.NewSource(1)))
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		if !l.Insert(k, "v") {
+			t.Fatalf("Expected %d to be inserted", k)
+		}
+	}
+	if l.Insert(30, "thirty") {
+		t.Fatal("Expected 30 to be updated, not inserted")
+	}
+	if v, ok := l.Get(30); !ok || v != "thirty" {
+		t.Fatalf("Expected thirty, got %q", v)
+	}
+	if _, ok := l.Get(35); ok || l.Contains(35) {
+		t.Fatal("Expected 35 to be missing")
+	}
+	if l.Len() != 5 {
+		t.Fatalf("Expected length 5, got %d", l.Len())
+	}
+
+	var cases = []struct {
+		key             int
+		floor, ceiling  int
+		floorOK, ceilOK bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{25, 20, 30, true, true},
+		{50, 50, 50, true, true},
+		{55, 50, 0, true, false},
+	}
+	for _, c := range cases {
+		if k, _, ok := l.Floor(c.key); ok != c.floorOK || k != c.floor {
+			t.Errorf("Floor(%d): expected %d, %v, got %d, %v", c.key, c.floor, c.floorOK, k, ok)
+		}
+		if k, _, ok := l.Ceiling(c.key); ok != c.ceilOK || k != c.ceiling {
+			t.Errorf("Ceiling(%d): expected %d, %v, got %d, %v", c.key, c.ceiling, c.ceilOK, k, ok)
+		}
+	}
+
+	var ranged []int
+	l.Range(20, 50, func(k int, _ string) bool {
+		ranged = append(ranged, k)
+		return true
+	})
+	if len(ranged) != 3 || ranged[0] != 20 || ranged[2] != 40 {
+		t.Fatalf("Expected [20 30 40], got %v", ranged)
+	}
+
+	if !l.Delete(10) || l.Delete(10) || l.Delete(35) {
+		t.Fatal("Unexpected Delete result")
+	}
+	if k, _, ok := l.Floor(15); ok {
+		t.Fatalf("Expected no floor for 15, got %d", k)
+	}
+	if ks := keys(l); len(ks) != 4 || ks[0] != 20 || l.Len() != 4 {
+		t.Fatalf("Unexpected keys: %v", ks)
+	}
+}
+
+func TestSkipListFunc(t *testing.T) {
+	// Case-insensitive keys.
+	var l = skiplist.NewFunc[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	l.Insert("b", 1)
+	l.Insert("A", 2)
+	l.Insert("B", 3)
+	if ks := keys(l); len(ks) != 2 || ks[0] != "A" || ks[1] != "b" {
+		t.Fatalf("Unexpected keys: %v", ks)
+	}
+	if v, ok := l.Get("b"); !ok || v != 3 {
+		t.Fatalf("Expected 3, got %d", v)
+	}
+}
+
+// Compare against a map under random operations.
+func TestSkipListRandom(t *testing.T) {
+	var (
+		r    = rand.New(rand.NewSource(2))
+		l    = skiplist.New[int, int](skiplist.WithRandSource(rand.NewSource(2)))
+		want = make(map[int]int)
+	)
+	for i := 0; i < 10000; i++ {
+		var k = r.Intn(500)
+		if r.Intn(3) == 0 {
+			var _, present = want[k]
+			if l.Delete(k) != present {
+				t.Fatalf("Delete(%d): expected %v", k, present)
+			}
+			delete(want, k)
+		} else {
+			var _, present = want[k]
+			if l.Insert(k, i) == present {
+				t.Fatalf("Insert(%d): expected %v", k, !present)
+			}
+			want[k] = i
+		}
+	}
+
+	var sorted = make([]int, 0, len(want))
+	for k := range want {
+		sorted = append(sorted, k)
+	}
+	sort.Ints(sorted)
+
+	var ks = keys(l)
+	if len(ks) != len(sorted) || l.Len() != len(sorted) {
+		t.Fatalf("Expected %d keys, got %d (Len %d)", len(sorted), len(ks), l.Len())
+	}
+	for i, k := range sorted {
+		if ks[i] != k {
+			t.Fatalf("Index %d: expected %d, got %d", i, k, ks[i])
+		}
+		if v, _ := l.Get(k); v != want[k] {
+			t.Fatalf("Get(%d): expected %d, got %d", k, want[k], v)
+		}
+	}
+}
+
+func TestSkipListConcurrent(t *testing.T) {
+	const workers, perWorker = 8, 2000
+	var (
+		l  = skiplist.New[int, int]()
+		wg sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			var r = rand.New(rand.NewSource(int64(w)))
+			for i := 0; i < perWorker; i++ {
+				// Every worker owns the keys equal to w modulo workers,
+				// and also reads and writes a small shared range.
+				var own = (r.Intn(perWorker) * workers) + w
+				l.Insert(own, w)
+				if i%2 == 0 {
+					l.Delete(own)
+				}
+				var shared = -1 - r.Intn(16)
+				switch r.Intn(4) {
+				case 0:
+					l.Delete(shared)
+				case 1:
+					l.Get(shared)
+				case 2:
+					l.Floor(shared)
+				default:
+					l.Insert(shared, w)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	var ks = keys(l)
+	if len(ks) != l.Len() {
+		t.Fatalf("Expected %d keys, got %d", l.Len(), len(ks))
+	}
+	for i := 1; i < len(ks); i++ {
+		if ks[i-1] >= ks[i] {
+			t.Fatalf("Keys out of order: %d before %d", ks[i-1], ks[i])
+		}
+	}
+	for _, k := range ks {
+		if k >= 0 {
+			if v, ok := l.Get(k); !ok || v != k%workers {
+				t.Fatalf("Get(%d): expected %d, got %d", k, k%workers, v)
+			}
+		}
+	}
+}
+
+const benchKeys = 1 << 14
+
+func BenchmarkSkipList(b *testing.B) {
+	var l = skiplist.New[int, int]()
+	for i := 0; i < benchKeys; i++ {
+		l.Insert(rand.Intn(benchKeys), i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var r = rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			var k = r.Intn(benchKeys)
+			switch r.Intn(10) {
+			case 0:
+				l.Insert(k, k)
+			case 1:
+				l.Delete(k)
+			default:
+				l.Get(k)
+			}
+		}
+	})
+}
+
+func BenchmarkMutexBST(b *testing.B) {
+	var (
+		mu sync.RWMutex
+		t  = binarytree.NewBST(benchKeys / 2)
+	)
+	for i := 0; i < benchKeys; i++ {
+		t.Insert(rand.Intn(benchKeys))
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var r = rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			var k = r.Intn(benchKeys)
+			switch r.Intn(10) {
+			case 0:
+				mu.Lock()
+				t.Insert(k)
+				mu.Unlock()
+			case 1:
+				mu.Lock()
+				t.Delete(k)
+				mu.Unlock()
+			default:
+				mu.RLock()
+				t.Search(k)
+				mu.RUnlock()
+			}
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package stack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/internal/signal"
+	"github.com/Nigel2392/go-datastructures/linkedlist"
+)
+
+// What a Bounded stack does when a value is pushed while it is full.
+type OverflowPolicy int
+
+const (
+	// Reject the value, Push returns ErrFull.
+	Reject OverflowPolicy = iota
+	// Evict the oldest value at the bottom of the stack to make room.
+	DropOldest
+	// Wait until a value is popped.
+	Block
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case Reject:
+		return "Reject"
+	case DropOldest:
+		return "DropOldest"
+	case Block:
+		return "Block"
+	}
+	return fmt.Sprintf("OverflowPolicy(%d)", int(p))
+}
+
+// Bounded is a stack which holds at most a fixed number of values.
+//
+// It is implemented using a doubly linked list, with the top of the stack at the head,
+// so the oldest value at the bottom can be evicted in constant time.
+//
+// All methods are safe for concurrent use.
+type Bounded[T any] struct {
+	mu       sync.Mutex
+	list     linkedlist.Doubly[T]
+	capacity int
+	policy   OverflowPolicy
+	onEvict  func(T)
+
+	pushed signal.Signal
+	popped signal.Signal
+}
+
+// Create a new bounded stack which holds at most capacity values.
+//
+// The capacity must be positive.
+func NewBounded[T any](capacity int, policy OverflowPolicy) *Bounded[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("NewBounded[T] requires a positive capacity, %d given", capacity))
+	}
+	return &Bounded[T]{
+		capacity: capacity,
+		policy:   policy,
+	}
+}
+
+// OnEvict sets a function which is called with every value evicted by the DropOldest policy.
+//
+// It is called after the stack is unlocked, so it may use the stack.
+func (s *Bounded[T]) OnEvict(f func(evicted T)) {
+	s.mu.Lock()
+	s.onEvict = f
+	s.mu.Unlock()
+}
+
+// Push adds a value to the top of the stack
+//
+// When the stack is full, the overflow policy decides what happens:
+// Reject returns ErrFull, DropOldest evicts the bottom value and returns it with ok set to true,
+// and Block waits until a value is popped.
+func (s *Bounded[T]) Push(value T) (evicted T, ok bool, err error) {
+	return s.PushContext(context.Background(), value)
+}
+
+// PushContext adds a value to the top of the stack, like Push.
+//
+// With the Block policy it returns the context's error
+// if the context is done before there is room for the value.
+func (s *Bounded[T]) PushContext(ctx context.Context, value T) (evicted T, ok bool, err error) {
+	if s.policy == Block {
+		_, err = signal.WaitContext(ctx, &s.mu, &s.popped, func() (struct{}, bool) {
+			if s.list.Len() >= s.capacity {
+				return struct{}{}, false
+			}
+			s.push(value)
+			return struct{}{}, true
+		})
+		return evicted, false, err
+	}
+
+	s.mu.Lock()
+	if s.list.Len() >= s.capacity {
+		if s.policy != DropOldest {
+			s.mu.Unlock()
+			return evicted, false, ErrFull
+		}
+		evicted, ok = s.list.Pop(), true
+	}
+	s.push(value)
+	var onEvict = s.onEvict
+	s.mu.Unlock()
+
+	if ok && onEvict != nil {
+		onEvict(evicted)
+	}
+	return evicted, ok, nil
+}
+
+// Pop removes a value from the top of the stack
+//
+// It panics if the stack is empty.
+func (s *Bounded[T]) Pop() T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var v, ok = s.tryPop()
+	if !ok {
+		panic("cannot Pop() from an empty stack")
+	}
+	return v
+}
+
+// PopOK removes a value from the top of the stack
+//
+// It returns the value and a boolean indicating whether the value was removed
+func (s *Bounded[T]) PopOK() (value T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tryPop()
+}
+
+// PopContext removes a value from the top of the stack,
+// waiting for one to be pushed if the stack is empty.
+//
+// It returns the context's error if the context is done before a value is available.
+func (s *Bounded[T]) PopContext(ctx context.Context) (value T, err error) {
+	return signal.WaitContext(ctx, &s.mu, &s.pushed, s.tryPop)
+}
+
+// PopTimeout removes a value from the top of the stack,
+// waiting up to d for one to be pushed if the stack is empty.
+//
+// It returns false if no value became available in time.
+func (s *Bounded[T]) PopTimeout(d time.Duration) (value T, ok bool) {
+	return signal.WaitTimeout(d, &s.mu, &s.pushed, s.tryPop)
+}
+
+// Push a value onto a stack with room, must be called with the lock held.
+func (s *Bounded[T]) push(value T) {
+	s.list.Prepend(value)
+	s.pushed.Notify()
+}
+
+// Pop a value if there is one, must be called with the lock held.
+func (s *Bounded[T]) tryPop() (value T, ok bool) {
+	if s.list.Len() == 0 {
+		return
+	}
+	value = s.list.Shift()
+	s.popped.Notify()
+	return value, true
+}
+
+// Peek returns the value at the top of the stack
+//
+// It returns the zero value if the stack is empty.
+func (s *Bounded[T]) Peek() (value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var head = s.list.Head()
+	if head == nil {
+		return
+	}
+	return head.Value()
+}
+
+// Bottom returns the oldest value in the stack, the next one to be evicted.
+//
+// It returns false if the stack is empty.
+func (s *Bounded[T]) Bottom() (value T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var tail = s.list.Tail()
+	if tail == nil {
+		return
+	}
+	return tail.Value(), true
+}
+
+// Len returns the number of values in the stack.
+func (s *Bounded[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Len()
+}
+
+// Cap returns the maximum number of values the stack holds.
+func (s *Bounded[T]) Cap() int {
+	return s.capacity
+}
@@ -0,0 +1,89 @@
+package stack_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/stack"
+)
+
+func TestBoundedReject(t *testing.T) {
+	var s = stack.NewBounded[int](2, stack.Reject)
+	s.Push(1)
+	s.Push(2)
+	if _, ok, err := s.Push(3); ok || !errors.Is(err, stack.ErrFull) {
+		t.Fatalf("Expected %v, got %v", stack.ErrFull, err)
+	}
+	if s.Len() != 2 || s.Peek() != 2 || s.Cap() != 2 {
+		t.Fatalf("Expected [2 1], got top %d of %d", s.Peek(), s.Len())
+	}
+	s.Pop()
+	if _, _, err := s.Push(3); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBoundedDropOldest(t *testing.T) {
+	var (
+		s       = stack.NewBounded[string](3, stack.DropOldest)
+		evicted []string
+	)
+	s.OnEvict(func(v string) {
+		evicted = append(evicted, v)
+	})
+	for _, v := range []string{"a", "b", "c"} {
+		if _, ok, err := s.Push(v); ok || err != nil {
+			t.Fatalf("Expected %q to fit", v)
+		}
+	}
+	if v, ok, err := s.Push("d"); !ok || err != nil || v != "a" {
+		t.Fatalf("Expected a to be evicted, got %q, %v, %v", v, ok, err)
+	}
+	s.Push("e")
+	if len(evicted) != 2 || evicted[0] != "a" || evicted[1] != "b" {
+		t.Fatalf("Expected [a b] evicted, got %v", evicted)
+	}
+	if v, _ := s.Bottom(); v != "c" {
+		t.Fatalf("Expected c at the bottom, got %q", v)
+	}
+	if s.Pop() != "e" || s.Pop() != "d" || s.Pop() != "c" {
+		t.Fatal("Unexpected pop order")
+	}
+	if _, ok := s.Bottom(); ok {
+		t.Fatal("Expected an empty stack")
+	}
+}
+
+func TestBoundedBlock(t *testing.T) {
+	var s = stack.NewBounded[int](1, stack.Block)
+	s.Push(1)
+
+	var ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	if _, _, err := s.PushContext(ctx, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	var pushed = make(chan struct{})
+	go func() {
+		s.Push(2)
+		close(pushed)
+	}()
+	select {
+	case <-pushed:
+		t.Fatal("Expected Push to block while the stack is full")
+	case <-time.After(5 * time.Millisecond):
+	}
+	if v, ok := s.PopTimeout(time.Second); !ok || v != 1 {
+		t.Fatalf("Expected 1, got %d", v)
+	}
+	<-pushed
+	if v, err := s.PopContext(context.Background()); err != nil || v != 2 {
+		t.Fatalf("Expected 2, got %d (%v)", v, err)
+	}
+	if _, ok := s.PopOK(); ok {
+		t.Fatal("Expected an empty stack")
+	}
+}
@@ -0,0 +1,167 @@
+package stack
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/internal/signal"
+)
+
+type concurrentNode[T any] struct {
+	value T
+	next  *concurrentNode[T]
+}
+
+// Concurrent is a lock-free stack, safe for use by many goroutines at once.
+//
+// It is a Treiber stack: every Push and Pop is a single compare-and-swap on the top node.
+// A new node is allocated for every pushed value and nodes are never reused,
+// so the garbage collector rules out the ABA problem.
+//
+// Goroutines can block until a value is pushed with PopContext or PopTimeout,
+// a lock is only taken when there are waiters to wake.
+//
+// The zero value is an empty stack, ready to use.
+// A stack must not be copied after first use.
+type Concurrent[T any] struct {
+	top atomic.Pointer[concurrentNode[T]]
+	len atomic.Int64
+
+	waiters atomic.Int64
+	mu      sync.Mutex
+	pushed  signal.Signal
+}
+
+// Push adds a value to the top of the stack
+func (s *Concurrent[T]) Push(value T) {
+	var n = &concurrentNode[T]{value: value}
+	s.pushChain(n, n, 1)
+}
+
+// PushAll adds the values to the stack, as if pushed one by one in order.
+//
+// The last value ends up on top.
+// The values are pushed atomically: no other goroutine sees only some of them.
+func (s *Concurrent[T]) PushAll(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+	var bottom = &concurrentNode[T]{value: values[0]}
+	var top = bottom
+	for _, v := range values[1:] {
+		top = &concurrentNode[T]{value: v, next: top}
+	}
+	s.pushChain(top, bottom, len(values))
+}
+
+// Link the chain from top to bottom onto the stack.
+func (s *Concurrent[T]) pushChain(top, bottom *concurrentNode[T], n int) {
+	for {
+		var old = s.top.Load()
+		bottom.next = old
+		if s.top.CompareAndSwap(old, top) {
+			break
+		}
+	}
+	s.len.Add(int64(n))
+
+	if s.waiters.Load() > 0 {
+		s.mu.Lock()
+		for i := 0; i < n; i++ {
+			s.pushed.Notify()
+		}
+		s.mu.Unlock()
+	}
+}
+
+// Pop removes a value from the top of the stack
+//
+// It panics if the stack is empty.
+func (s *Concurrent[T]) Pop() T {
+	var v, ok = s.PopOK()
+	if !ok {
+		panic("cannot Pop() from an empty stack")
+	}
+	return v
+}
+
+// PopOK removes a value from the top of the stack
+//
+// It returns the value and a boolean indicating whether the value was removed
+func (s *Concurrent[T]) PopOK() (value T, ok bool) {
+	for {
+		var top = s.top.Load()
+		if top == nil {
+			return
+		}
+		if s.top.CompareAndSwap(top, top.next) {
+			s.len.Add(-1)
+			return top.value, true
+		}
+	}
+}
+
+// PopContext removes a value from the top of the stack,
+// waiting for one to be pushed if the stack is empty.
+//
+// It returns the context's error if the context is done before a value is available.
+func (s *Concurrent[T]) PopContext(ctx context.Context) (value T, err error) {
+	if value, ok := s.PopOK(); ok {
+		return value, nil
+	}
+	// Pushers only take the lock to wake waiters once they see the count,
+	// so it must be raised before the stack is checked again under the lock.
+	s.waiters.Add(1)
+	defer s.waiters.Add(-1)
+	return signal.WaitContext(ctx, &s.mu, &s.pushed, s.PopOK)
+}
+
+// PopTimeout removes a value from the top of the stack,
+// waiting up to d for one to be pushed if the stack is empty.
+//
+// It returns false if no value became available in time.
+func (s *Concurrent[T]) PopTimeout(d time.Duration) (value T, ok bool) {
+	if value, ok = s.PopOK(); ok {
+		return value, true
+	}
+	s.waiters.Add(1)
+	defer s.waiters.Add(-1)
+	return signal.WaitTimeout(d, &s.mu, &s.pushed, s.PopOK)
+}
+
+// Drain removes all values from the stack at once.
+//
+// It returns the values in the order they would have been popped, top first.
+func (s *Concurrent[T]) Drain() []T {
+	var top = s.top.Swap(nil)
+	var values []T
+	for n := top; n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+	s.len.Add(-int64(len(values)))
+	return values
+}
+
+// Peek returns the value at the top of the stack
+//
+// It returns the zero value if the stack is empty.
+func (s *Concurrent[T]) Peek() (value T) {
+	var top = s.top.Load()
+	if top == nil {
+		return
+	}
+	return top.value
+}
+
+// Len returns the number of values in the stack.
+//
+// The length is approximate while values are being pushed or popped concurrently.
+func (s *Concurrent[T]) Len() int {
+	var n = s.len.Load()
+	if n < 0 {
+		return 0
+	}
+	return int(n)
+}
@@ -0,0 +1,153 @@
+package stack_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/stack"
+)
+
+func TestConcurrent(t *testing.T) {
+	var s stack.Concurrent[int]
+	if _, ok := s.PopOK(); ok || s.Len() != 0 || s.Peek() != 0 {
+		t.Fatal("Expected an empty stack")
+	}
+	s.Push(1)
+	s.PushAll(2, 3, 4)
+	s.Push(5)
+	if s.Len() != 5 || s.Peek() != 5 {
+		t.Fatalf("Expected 5 values with 5 on top, got %d with %d", s.Len(), s.Peek())
+	}
+	if s.Pop() != 5 || s.Pop() != 4 {
+		t.Fatal("Unexpected pop order")
+	}
+
+	var drained = s.Drain()
+	if len(drained) != 3 || drained[0] != 3 || drained[2] != 1 || s.Len() != 0 {
+		t.Fatalf("Expected [3 2 1], got %v", drained)
+	}
+	if s.Drain() != nil {
+		t.Fatal("Expected nothing to drain")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected Pop to panic on an empty stack")
+		}
+	}()
+	s.Pop()
+}
+
+func TestConcurrentStress(t *testing.T) {
+	const workers, perWorker = 8, 5000
+	var (
+		s       stack.Concurrent[int]
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		popped  = make(map[int]bool)
+		collect = func(v int) {
+			mu.Lock()
+			if popped[v] {
+				t.Errorf("%d popped twice", v)
+			}
+			popped[v] = true
+			mu.Unlock()
+		}
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				var v = w*perWorker + i
+				if i%10 == 0 {
+					s.PushAll(v)
+				} else {
+					s.Push(v)
+				}
+				if i%2 == 0 {
+					if v, ok := s.PopOK(); ok {
+						collect(v)
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, v := range s.Drain() {
+		collect(v)
+	}
+	if len(popped) != workers*perWorker || s.Len() != 0 {
+		t.Fatalf("Expected %d values, got %d", workers*perWorker, len(popped))
+	}
+}
+
+func TestConcurrentPopContext(t *testing.T) {
+	var s stack.Concurrent[int]
+	var ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.PopContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if _, ok := s.PopTimeout(time.Millisecond); ok {
+		t.Fatal("Expected PopTimeout to time out")
+	}
+
+	const waiters = 20
+	var (
+		wg  sync.WaitGroup
+		sum = make(chan int, waiters)
+	)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var v, err = s.PopContext(context.Background())
+			if err != nil {
+				t.Error(err)
+			}
+			sum <- v
+		}()
+	}
+	time.Sleep(time.Millisecond)
+	for i := 1; i <= waiters/2; i++ {
+		s.Push(i)
+	}
+	s.PushAll(11, 12, 13, 14, 15, 16, 17, 18, 19, 20)
+	wg.Wait()
+	close(sum)
+
+	var total int
+	for v := range sum {
+		total += v
+	}
+	if total != waiters*(waiters+1)/2 {
+		t.Fatalf("Expected a total of %d, got %d", waiters*(waiters+1)/2, total)
+	}
+}
+
+func BenchmarkConcurrentPushPop(b *testing.B) {
+	var s stack.Concurrent[int]
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Push(1)
+			s.PopOK()
+		}
+	})
+}
+
+func BenchmarkStackPushPop(b *testing.B) {
+	var s stack.Stack[int]
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Push(1)
+			s.PopOK()
+		}
+	})
+}
@@ -0,0 +1,6 @@
+package stack
+
+import "errors"
+
+// Returned when pushing onto a full Bounded stack with the Reject policy.
+var ErrFull = errors.New("stack: stack is full")
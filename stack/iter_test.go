@@ -0,0 +1,95 @@
+package stack_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Nigel2392/go-datastructures/stack"
+)
+
+func eqInt(a, b int) bool { return a == b }
+
+func testIteration(t *testing.T, s stack.Interface[int]) {
+	for i := 1; i <= 4; i++ {
+		s.Push(i)
+	}
+
+	var values []int
+	s.Each(func(v int) bool {
+		values = append(values, v)
+		return v != 2
+	})
+	if len(values) != 3 || values[0] != 4 || values[2] != 2 {
+		t.Fatalf("Expected [4 3 2], got %v", values)
+	}
+	if slice := s.ToSlice(); len(slice) != 4 || slice[0] != 4 || slice[3] != 1 {
+		t.Fatalf("Expected [4 3 2 1], got %v", slice)
+	}
+	if s.String() != "[4, 3, 2, 1]" {
+		t.Fatalf("Expected [4, 3, 2, 1], got %s", s)
+	}
+
+	if s.Search(4, eqInt) != 0 || s.Search(1, eqInt) != 3 || s.Search(5, eqInt) != -1 {
+		t.Fatal("Unexpected Search result")
+	}
+	if !s.Contains(3, eqInt) || s.Contains(0, eqInt) {
+		t.Fatal("Unexpected Contains result")
+	}
+}
+
+func TestStackIteration(t *testing.T) {
+	var s stack.Stack[int]
+	testIteration(t, &s)
+
+	var clone = s.Clone()
+	clone.Pop()
+	if s.Len() != 4 || clone.Len() != 3 || clone.Peek() != 3 {
+		t.Fatal("Expected the clone to be independent")
+	}
+}
+
+func TestSliceIteration(t *testing.T) {
+	var s stack.Slice[int]
+	testIteration(t, &s)
+
+	var clone = s.Clone()
+	clone.Pop()
+	if s.Len() != 4 || clone.Len() != 3 || clone.Peek() != 3 {
+		t.Fatal("Expected the clone to be independent")
+	}
+}
+
+func TestStackJSON(t *testing.T) {
+	var s stack.Stack[int]
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	var data, err = json.Marshal(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "[3,2,1]" {
+		t.Fatalf("Expected [3,2,1], got %s", data)
+	}
+
+	var decoded stack.Stack[int]
+	decoded.Push(10)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.String() != s.String() || decoded.Pop() != 3 {
+		t.Fatalf("Expected %s after a round-trip, got %s", s.String(), decoded.String())
+	}
+
+	// Slice and Stack use the same encoding.
+	var slice stack.Slice[int]
+	if err := json.Unmarshal(data, &slice); err != nil {
+		t.Fatal(err)
+	}
+	if slice.String() != s.String() || slice.Pop() != 3 {
+		t.Fatalf("Expected %s after decoding, got %s", s.String(), slice.String())
+	}
+	if data, _ := json.Marshal(&slice); string(data) != "[2,1]" {
+		t.Fatalf("Expected [2,1], got %s", data)
+	}
+}
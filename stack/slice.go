@@ -0,0 +1,394 @@
+package stack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/internal/signal"
+)
+
+// The capacity a Slice stack never shrinks below.
+const minSliceCap = 16
+
+// Interface is the method set shared by Stack and Slice,
+// so code can switch between the two implementations.
+type Interface[T any] interface {
+	Push(value T)
+	Pop() T
+	PopOK() (value T, ok bool)
+	PopContext(ctx context.Context) (value T, err error)
+	PopTimeout(d time.Duration) (value T, ok bool)
+	PopWaiter(sleep time.Duration) <-chan T
+	PopOKDeadline(deadline time.Duration) (ret <-chan T, ok <-chan time.Time)
+	Peek() (value T)
+	Len() int
+	Each(f func(T) bool)
+	ToSlice() []T
+	Contains(value T, eq func(a, b T) bool) bool
+	Search(value T, eq func(a, b T) bool) int
+	String() string
+}
+
+var (
+	_ Interface[int] = (*Stack[int])(nil)
+	_ Interface[int] = (*Slice[int])(nil)
+)
+
+// Slice is a stack backed by a slice.
+//
+// Unlike Stack, it does not allocate a node for every pushed value:
+// Push and Pop are amortized O(1), and the slice shrinks again
+// once less than a quarter of it is in use,
+// though never below the capacity asked for through NewSlice or Reserve.
+//
+// It has the same method set as Stack, and adds operations on the top values
+// like PeekN, PopN, Swap, Dup and Rot.
+//
+// All methods are safe for concurrent use.
+//
+// The zero value is an empty stack, ready to use.
+// A stack must not be copied after first use.
+type Slice[T any] struct {
+	mu    sync.Mutex
+	items []T
+	// the capacity asked for through NewSlice or Reserve, the slice never shrinks below it.
+	reserved int
+	pushed   signal.Signal
+}
+
+// Create a new slice stack with room for capacity values.
+func NewSlice[T any](capacity int) *Slice[T] {
+	return &Slice[T]{
+		items:    make([]T, 0, capacity),
+		reserved: capacity,
+	}
+}
+
+// Push adds a value to the top of the stack
+//
+// If goroutines are waiting for a value, the one which has waited longest is woken up.
+func (s *Slice[T]) Push(value T) {
+	s.mu.Lock()
+	s.items = append(s.items, value)
+	s.pushed.Notify()
+	s.mu.Unlock()
+}
+
+// Pop removes a value from the top of the stack
+//
+// It panics if the stack is empty.
+func (s *Slice[T]) Pop() T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.items) == 0 {
+		panic("cannot Pop() from an empty stack")
+	}
+	return s.pop()
+}
+
+// PopOK removes a value from the top of the stack
+//
+// It returns the value and a boolean indicating whether the value was removed
+func (s *Slice[T]) PopOK() (value T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tryPop()
+}
+
+// PopContext removes a value from the top of the stack,
+// waiting for one to be pushed if the stack is empty.
+//
+// It returns the context's error if the context is done before a value is available.
+func (s *Slice[T]) PopContext(ctx context.Context) (value T, err error) {
+	return signal.WaitContext(ctx, &s.mu, &s.pushed, s.tryPop)
+}
+
+// PopTimeout removes a value from the top of the stack,
+// waiting up to d for one to be pushed if the stack is empty.
+//
+// It returns false if no value became available in time.
+func (s *Slice[T]) PopTimeout(d time.Duration) (value T, ok bool) {
+	return signal.WaitTimeout(d, &s.mu, &s.pushed, s.tryPop)
+}
+
+// PopWaiter allows you to wait on a value through a channel.
+//
+// It behaves like Stack.PopWaiter.
+func (s *Slice[T]) PopWaiter(sleep time.Duration) <-chan T {
+	return signal.Waiter(&s.mu, &s.pushed, s.tryPop)
+}
+
+// PopOKDeadline returns a channel where the value will be sent when it is available
+//
+// It behaves like Stack.PopOKDeadline.
+func (s *Slice[T]) PopOKDeadline(deadline time.Duration) (ret <-chan T, ok <-chan time.Time) {
+	return signal.WaitDeadline(deadline, &s.mu, &s.pushed, s.tryPop)
+}
+
+// Peek returns the value at the top of the stack
+//
+// It returns the zero value if the stack is empty.
+func (s *Slice[T]) Peek() (value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.items) == 0 {
+		return
+	}
+	return s.items[len(s.items)-1]
+}
+
+// PeekN returns up to n values from the top of the stack, top first.
+//
+// It returns fewer values if the stack holds fewer than n.
+func (s *Slice[T]) PeekN(n int) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.topN(n)
+}
+
+// PopN removes up to n values from the top of the stack, and returns them top first.
+//
+// It returns fewer values if the stack holds fewer than n.
+func (s *Slice[T]) PopN(n int) []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var values = s.topN(n)
+	var rest = len(s.items) - len(values)
+	clearSlice(s.items[rest:])
+	s.items = s.items[:rest]
+	s.shrink()
+	return values
+}
+
+// Swap exchanges the two values at the top of the stack.
+//
+// It returns false if the stack holds fewer than two values.
+func (s *Slice[T]) Swap() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var n = len(s.items)
+	if n < 2 {
+		return false
+	}
+	s.items[n-1], s.items[n-2] = s.items[n-2], s.items[n-1]
+	return true
+}
+
+// Dup pushes a copy of the value at the top of the stack.
+//
+// It returns false if the stack is empty.
+func (s *Slice[T]) Dup() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.items) == 0 {
+		return false
+	}
+	s.items = append(s.items, s.items[len(s.items)-1])
+	s.pushed.Notify()
+	return true
+}
+
+// Rot moves the third value from the top to the top,
+// shifting the two values above it down: (a b c -- b c a), with c on top.
+//
+// It returns false if the stack holds fewer than three values.
+func (s *Slice[T]) Rot() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var n = len(s.items)
+	if n < 3 {
+		return false
+	}
+	var a = s.items[n-3]
+	s.items[n-3], s.items[n-2], s.items[n-1] = s.items[n-2], s.items[n-1], a
+	return true
+}
+
+// Reserve makes sure n more values can be pushed without growing the slice.
+//
+// Popping values does not shrink the slice below the reserved room.
+func (s *Slice[T]) Reserve(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.items)+n > s.reserved {
+		s.reserved = len(s.items) + n
+	}
+	if cap(s.items)-len(s.items) >= n {
+		return
+	}
+	var items = make([]T, len(s.items), len(s.items)+n)
+	copy(items, s.items)
+	s.items = items
+}
+
+// Len returns the number of values in the stack.
+func (s *Slice[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// Cap returns the number of values the stack can hold before it grows.
+func (s *Slice[T]) Cap() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cap(s.items)
+}
+
+// Each calls f for every value in the stack, from top to bottom.
+//
+// Iteration stops when f returns false.
+// The stack is locked while iterating, so f must not use the stack.
+func (s *Slice[T]) Each(f func(T) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if !f(s.items[i]) {
+			return
+		}
+	}
+}
+
+// ToSlice returns the values in the stack, from top to bottom.
+func (s *Slice[T]) ToSlice() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.topN(len(s.items))
+}
+
+// Contains reports whether the stack holds the value, using eq to compare values.
+func (s *Slice[T]) Contains(value T, eq func(a, b T) bool) bool {
+	return s.Search(value, eq) != -1
+}
+
+// Search returns the distance of the value from the top of the stack, using eq to compare values.
+//
+// The top value has distance 0.
+// It returns -1 if the value is not in the stack.
+func (s *Slice[T]) Search(value T, eq func(a, b T) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.items) - 1; i >= 0; i-- {
+		if eq(s.items[i], value) {
+			return len(s.items) - 1 - i
+		}
+	}
+	return -1
+}
+
+// String returns the stack as a string, from top to bottom.
+func (s *Slice[T]) String() string {
+	var b strings.Builder
+	b.WriteString("[")
+	var first = true
+	s.Each(func(v T) bool {
+		if !first {
+			b.WriteString(", ")
+		}
+		first = false
+		fmt.Fprintf(&b, "%v", v)
+		return true
+	})
+	b.WriteString("]")
+	return b.String()
+}
+
+// Clone returns a new stack with the same values.
+func (s *Slice[T]) Clone() *Slice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var items = make([]T, len(s.items))
+	copy(items, s.items)
+	return &Slice[T]{items: items}
+}
+
+// MarshalJSON encodes the stack as an array, from top to bottom.
+//
+// This is the same encoding as Stack uses.
+func (s *Slice[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the values in the stack with an array, from top to bottom.
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	// The slice is stored bottom first.
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.items = values
+	if len(values) > 0 {
+		s.pushed.Broadcast()
+	}
+	return nil
+}
+
+// Pop a value if there is one, must be called with the lock held.
+func (s *Slice[T]) tryPop() (value T, ok bool) {
+	if len(s.items) == 0 {
+		return
+	}
+	return s.pop(), true
+}
+
+func (s *Slice[T]) pop() T {
+	var last = len(s.items) - 1
+	var v = s.items[last]
+	var zero T
+	s.items[last] = zero
+	s.items = s.items[:last]
+	s.shrink()
+	return v
+}
+
+// Returns a copy of up to n values from the top, top first.
+func (s *Slice[T]) topN(n int) []T {
+	if n > len(s.items) {
+		n = len(s.items)
+	}
+	if n <= 0 {
+		return nil
+	}
+	var values = make([]T, n)
+	for i := range values {
+		values[i] = s.items[len(s.items)-1-i]
+	}
+	return values
+}
+
+// Halve the slice when less than a quarter of it is in use,
+// so a stack which once grew large does not hold on to the memory.
+//
+// It never shrinks below minSliceCap or the reserved capacity.
+func (s *Slice[T]) shrink() {
+	var floor = s.reserved
+	if floor < minSliceCap {
+		floor = minSliceCap
+	}
+	if cap(s.items) <= floor || len(s.items) >= cap(s.items)/4 {
+		return
+	}
+	var newCap = cap(s.items) / 2
+	if newCap < floor {
+		newCap = floor
+	}
+	var items = make([]T, len(s.items), newCap)
+	copy(items, s.items)
+	s.items = items
+}
+
+func clearSlice[T any](items []T) {
+	var zero T
+	for i := range items {
+		items[i] = zero
+	}
+}
@@ -0,0 +1,130 @@
+package stack_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Nigel2392/go-datastructures/stack"
+)
+
+func TestSlice(t *testing.T) {
+	var s stack.Slice[int]
+	for i := 1; i <= 5; i++ {
+		s.Push(i)
+	}
+	if s.Len() != 5 || s.Peek() != 5 {
+		t.Fatalf("Expected 5 values with 5 on top, got %d with %d", s.Len(), s.Peek())
+	}
+
+	if top := s.PeekN(3); len(top) != 3 || top[0] != 5 || top[2] != 3 {
+		t.Fatalf("Expected [5 4 3], got %v", top)
+	}
+	if !s.Swap() || s.Peek() != 4 {
+		t.Fatal("Expected Swap to put 4 on top")
+	}
+	// 1 2 3 5 4 -> 1 2 5 4 3
+	if !s.Rot() || s.Peek() != 3 {
+		t.Fatal("Expected Rot to put 3 on top")
+	}
+	if !s.Dup() || s.Len() != 6 {
+		t.Fatal("Expected Dup to push a copy")
+	}
+	if popped := s.PopN(4); len(popped) != 4 || popped[0] != 3 || popped[1] != 3 || popped[2] != 4 || popped[3] != 5 {
+		t.Fatalf("Expected [3 3 4 5], got %v", popped)
+	}
+	if popped := s.PopN(10); len(popped) != 2 || popped[0] != 2 {
+		t.Fatalf("Expected [2 1], got %v", popped)
+	}
+	if s.Swap() || s.Dup() || s.Rot() || s.PeekN(1) != nil {
+		t.Fatal("Expected operations on an empty stack to fail")
+	}
+	if _, ok := s.PopOK(); ok {
+		t.Fatal("Expected an empty stack")
+	}
+}
+
+func TestSliceShrink(t *testing.T) {
+	var s = stack.NewSlice[int](0)
+	for i := 0; i < 1000; i++ {
+		s.Push(i)
+	}
+	for s.Len() > 10 {
+		s.Pop()
+	}
+	if s.Cap() > 4*16 {
+		t.Fatalf("Expected the stack to shrink, got capacity %d", s.Cap())
+	}
+	if top := s.PeekN(10); top[0] != 9 || top[9] != 0 {
+		t.Fatalf("Expected [9 ... 0], got %v", top)
+	}
+}
+
+func TestSliceReservedCapacity(t *testing.T) {
+	var s = stack.NewSlice[int](1024)
+	s.Push(1)
+	s.Pop()
+	if s.Cap() != 1024 {
+		t.Fatalf("Expected NewSlice to keep a capacity of 1024, got %d", s.Cap())
+	}
+
+	s = stack.NewSlice[int](0)
+	s.Reserve(1000)
+	var reserved = s.Cap()
+	if reserved < 1000 {
+		t.Fatalf("Expected room for 1000 values, got %d", reserved)
+	}
+	for i := 0; i < 10; i++ {
+		s.Push(i)
+	}
+	s.Pop()
+	s.PopN(5)
+	if s.Cap() != reserved {
+		t.Fatalf("Expected Reserve to keep a capacity of %d, got %d", reserved, s.Cap())
+	}
+
+	// Growing past the reserved room shrinks back to it, but not below.
+	for i := 0; i < 5000; i++ {
+		s.Push(i)
+	}
+	for s.Len() > 0 {
+		s.Pop()
+	}
+	if s.Cap() != reserved {
+		t.Fatalf("Expected the stack to shrink back to %d, got %d", reserved, s.Cap())
+	}
+}
+
+func TestSliceWaiting(t *testing.T) {
+	var s stack.Slice[int]
+	if _, ok := s.PopTimeout(time.Millisecond); ok {
+		t.Fatal("Expected PopTimeout to time out")
+	}
+	go func() {
+		time.Sleep(time.Millisecond)
+		s.Push(1)
+	}()
+	if v, err := s.PopContext(context.Background()); err != nil || v != 1 {
+		t.Fatalf("Expected 1, got %d (%v)", v, err)
+	}
+}
+
+func benchmarkPushPop(b *testing.B, s stack.Interface[int]) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 64; j++ {
+			s.Push(j)
+		}
+		for j := 0; j < 64; j++ {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkStack(b *testing.B) {
+	benchmarkPushPop(b, new(stack.Stack[int]))
+}
+
+func BenchmarkSlice(b *testing.B) {
+	benchmarkPushPop(b, new(stack.Slice[int]))
+}
@@ -1,9 +1,12 @@
 package stack
 
 import (
+	"context"
+	"encoding/json"
 	"sync"
 	"time"
 
+	"github.com/Nigel2392/go-datastructures/internal/signal"
 	"github.com/Nigel2392/go-datastructures/linkedlist"
 )
 
@@ -15,20 +18,36 @@ import (
 //
 // This does mean that the data gets prepended at the start, and then shifted off,
 // as opposed to appended and then popped off.
-type Stack[T any] linkedlist.Singly[T]
+//
+// All methods are safe for concurrent use,
+// and goroutines can block until a value is pushed with PopContext or PopTimeout.
+//
+// The zero value is an empty stack, ready to use.
+// A stack must not be copied after first use.
+type Stack[T any] struct {
+	mu     sync.Mutex
+	list   linkedlist.Singly[T]
+	pushed signal.Signal
+}
 
 // Push adds a value to the top of the stack
 //
-// This is the same as Prepend in a linked list
+// This is the same as Prepend in a linked list.
+// If goroutines are waiting for a value, the one which has waited longest is woken up.
 func (s *Stack[T]) Push(value T) {
-	(*linkedlist.Singly[T])(s).Prepend(value)
+	s.mu.Lock()
+	s.list.Prepend(value)
+	s.pushed.Notify()
+	s.mu.Unlock()
 }
 
 // Pop removes a value from the top of the stack
 //
 // This is the same as Shift in a linked list
 func (s *Stack[T]) Pop() T {
-	return (*linkedlist.Singly[T])(s).Shift()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Shift()
 }
 
 // PopOK removes a value from the top of the stack
@@ -37,74 +56,60 @@ func (s *Stack[T]) Pop() T {
 //
 // It returns the value and a boolean indicating whether the value was removed
 func (s *Stack[T]) PopOK() (value T, ok bool) {
-	if s.Len() == 0 {
-		return
-	}
-	return (*linkedlist.Singly[T])(s).Shift(), true
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tryPop()
+}
+
+// PopContext removes a value from the top of the stack,
+// waiting for one to be pushed if the stack is empty.
+//
+// It returns the context's error if the context is done before a value is available.
+func (s *Stack[T]) PopContext(ctx context.Context) (value T, err error) {
+	return signal.WaitContext(ctx, &s.mu, &s.pushed, s.tryPop)
+}
+
+// PopTimeout removes a value from the top of the stack,
+// waiting up to d for one to be pushed if the stack is empty.
+//
+// It returns false if no value became available in time.
+func (s *Stack[T]) PopTimeout(d time.Duration) (value T, ok bool) {
+	return signal.WaitTimeout(d, &s.mu, &s.pushed, s.tryPop)
 }
 
 // PopWaiter allows you to wait on a value through a channel.
+//
+// The value is sent as soon as it is pushed, the sleep duration is no longer used.
+//
+// The goroutine delivering the value only exits once a value is pushed,
+// use PopContext to stop waiting early.
 func (s *Stack[T]) PopWaiter(sleep time.Duration) <-chan T {
-	var c = make(chan T, 1)
-	var mu = new(sync.Mutex)
-	go s.wait(mu, c, sleep)
-	return c
-}
-
-func (s *Stack[T]) wait(mu *sync.Mutex, c chan<- T, sleep time.Duration) {
-	for {
-		if s.Len() > 0 {
-			mu.Lock()
-			// Check again to make sure it wasn't removed
-			if s.Len() <= 0 {
-				mu.Unlock()
-				continue
-			}
-			c <- (*linkedlist.Singly[T])(s).Shift()
-			close(c)
-			mu.Unlock()
-			return
-		}
-		if sleep > 0 {
-			time.Sleep(sleep)
-		}
-	}
+	return signal.Waiter(&s.mu, &s.pushed, s.tryPop)
 }
 
 // PopOKDeadline returns a channel where the value will be sent when it is available
+//
+// If the deadline passes first, the current time is sent on ok and ret is closed without a value.
+// The waiting goroutine exits either way.
 func (s *Stack[T]) PopOKDeadline(deadline time.Duration) (ret <-chan T, ok <-chan time.Time) {
-	var deadlineWaiter = time.After(deadline)
-	var c = make(chan T, 1)
-	var mu = new(sync.Mutex)
-	go func(mut *sync.Mutex) {
-		for {
-			select {
-			case <-deadlineWaiter:
-				close(c)
-				return
-			default:
-				if s.Len() > 0 {
-					mut.Lock()
-					defer mut.Unlock()
-					if s.Len() > 0 {
-						c <- (*linkedlist.Singly[T])(s).Shift()
-						close(c)
-						return
-					}
-				}
-			}
-		}
-	}(mu)
-
-	return c, deadlineWaiter
+	return signal.WaitDeadline(deadline, &s.mu, &s.pushed, s.tryPop)
+}
 
+// Pop a value if there is one, must be called with the lock held.
+func (s *Stack[T]) tryPop() (value T, ok bool) {
+	if s.list.Len() == 0 {
+		return
+	}
+	return s.list.Shift(), true
 }
 
 // Peek returns the value at the top of the stack
 //
 // This is the same as Head in a linked list
 func (s *Stack[T]) Peek() (value T) {
-	var head = (*linkedlist.Singly[T])(s).Head()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var head = s.list.Head()
 	if head == nil {
 		return // value is zero value
 	}
@@ -112,5 +117,90 @@ func (s *Stack[T]) Peek() (value T) {
 }
 
 func (s *Stack[T]) Len() int {
-	return (*linkedlist.Singly[T])(s).Len()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Len()
+}
+
+// Each calls f for every value in the stack, from top to bottom.
+//
+// Iteration stops when f returns false.
+// The stack is locked while iterating, so f must not use the stack.
+func (s *Stack[T]) Each(f func(T) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.All()(func(_ int, v T) bool {
+		return f(v)
+	})
+}
+
+// ToSlice returns the values in the stack, from top to bottom.
+func (s *Stack[T]) ToSlice() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.ToSlice()
+}
+
+// Contains reports whether the stack holds the value, using eq to compare values.
+func (s *Stack[T]) Contains(value T, eq func(a, b T) bool) bool {
+	return s.Search(value, eq) != -1
+}
+
+// Search returns the distance of the value from the top of the stack, using eq to compare values.
+//
+// The top value has distance 0.
+// It returns -1 if the value is not in the stack.
+func (s *Stack[T]) Search(value T, eq func(a, b T) bool) int {
+	var distance, found = 0, -1
+	s.Each(func(v T) bool {
+		if eq(v, value) {
+			found = distance
+			return false
+		}
+		distance++
+		return true
+	})
+	return found
+}
+
+// String returns the stack as a string, from top to bottom.
+func (s *Stack[T]) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.String()
+}
+
+// Clone returns a new stack with the same values.
+func (s *Stack[T]) Clone() *Stack[T] {
+	var clone = new(Stack[T])
+	s.Each(func(v T) bool {
+		clone.list.Append(v)
+		return true
+	})
+	return clone
+}
+
+// MarshalJSON encodes the stack as an array, from top to bottom.
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the values in the stack with an array, from top to bottom.
+//
+// Encoding and then decoding a stack gives a stack with the same order.
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Reset()
+	for _, v := range values {
+		s.list.Append(v)
+	}
+	if len(values) > 0 {
+		s.pushed.Broadcast()
+	}
+	return nil
 }
@@ -1,7 +1,12 @@
 package stack_test
 
 import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Nigel2392/go-datastructures/stack"
 )
@@ -50,3 +55,93 @@ func TestStack(t *testing.T) {
 		t.Errorf("Expected 1, got %d", s.Pop())
 	}
 }
+
+func TestStackPopContext(t *testing.T) {
+	var s stack.Stack[int]
+	var ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.PopContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		s.Push(1)
+	}()
+	if v, err := s.PopContext(context.Background()); err != nil || v != 1 {
+		t.Fatalf("Expected 1, got %d (%v)", v, err)
+	}
+
+	if _, ok := s.PopTimeout(5 * time.Millisecond); ok {
+		t.Fatal("Expected PopTimeout to time out")
+	}
+	s.Push(2)
+	if v, ok := s.PopTimeout(time.Second); !ok || v != 2 {
+		t.Fatalf("Expected 2, got %d", v)
+	}
+}
+
+// Every waiter must get a distinct value, and none may panic on an empty stack.
+func TestStackManyWaiters(t *testing.T) {
+	const waiters = 50
+	var (
+		s    stack.Stack[int]
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = make(map[int]bool)
+	)
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var v, err = s.PopContext(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			if seen[v] {
+				t.Errorf("%d popped twice", v)
+			}
+			seen[v] = true
+			mu.Unlock()
+		}()
+	}
+	for i := 0; i < waiters; i++ {
+		s.Push(i)
+	}
+	wg.Wait()
+	if len(seen) != waiters || s.Len() != 0 {
+		t.Fatalf("Expected %d values popped, got %d", waiters, len(seen))
+	}
+}
+
+func TestStackWaiterChannels(t *testing.T) {
+	var s stack.Stack[int]
+	var before = runtime.NumGoroutine()
+
+	for i := 0; i < 10; i++ {
+		var ret, expired = s.PopOKDeadline(time.Millisecond)
+		<-expired
+		if _, ok := <-ret; ok {
+			t.Fatal("Expected no value after the deadline")
+		}
+	}
+
+	var c = s.PopWaiter(0)
+	var ret, _ = s.PopOKDeadline(time.Second)
+	s.Push(1)
+	s.Push(2)
+	var a, b = <-c, <-ret
+	if a+b != 3 {
+		t.Fatalf("Expected 1 and 2, got %d and %d", a, b)
+	}
+
+	// The waiting goroutines must all have exited.
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		t.Fatalf("Expected %d goroutines, got %d", before, n)
+	}
+}